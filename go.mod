@@ -1,8 +1,9 @@
 module github.com/ketion-so/go-notion
 
-go 1.16
+go 1.18
 
 require (
 	github.com/google/go-cmp v0.5.5
-	github.com/mitchellh/mapstructure v1.4.1 // indirect
+	github.com/mitchellh/mapstructure v1.4.1
+	gopkg.in/yaml.v3 v3.0.1
 )
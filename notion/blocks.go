@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/ketion-so/go-notion/notion/object"
-	"github.com/mitchellh/mapstructure"
 )
 
 const (
@@ -42,7 +42,7 @@ type ParagraphBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 	Children       []Block          `json:"children" mapstructure:"children"`
 }
 
@@ -51,6 +51,11 @@ func (b *ParagraphBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *ParagraphBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.ParagraphBlockType, &blockContent{Text: b.Text, Children: b.Children})
+}
+
 // HeadingOneBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct HeadingOneBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct HeadingOneBlock -add-tags json,mapstructure -w -transform snakecase
@@ -61,7 +66,7 @@ type HeadingOneBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 }
 
 // GetType retrieves the block type.
@@ -69,6 +74,11 @@ func (b *HeadingOneBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *HeadingOneBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.HeadingOneBlockType, &blockContent{Text: b.Text})
+}
+
 // HeadingTwoBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct HeadingTwoBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct HeadingTwoBlock -add-tags json,mapstructure -w -transform snakecase
@@ -79,7 +89,7 @@ type HeadingTwoBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 }
 
 // GetType retrieves the block type.
@@ -87,6 +97,11 @@ func (b *HeadingTwoBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *HeadingTwoBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.HeadingTwoBlockType, &blockContent{Text: b.Text})
+}
+
 // HeadingThreeBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct HeadingThreeBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct HeadingThreeBlock -add-tags json,mapstructure -w -transform snakecase
@@ -97,7 +112,7 @@ type HeadingThreeBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 }
 
 // GetType retrieves the block type.
@@ -105,6 +120,11 @@ func (b *HeadingThreeBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *HeadingThreeBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.HeadingThreeBlockType, &blockContent{Text: b.Text})
+}
+
 // BulletedListItemBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct BulletedListItemBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct BulletedListItemBlock -add-tags json,mapstructure -w -transform snakecase
@@ -115,7 +135,7 @@ type BulletedListItemBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 	Children       []Block          `json:"children" mapstructure:"children"`
 }
 
@@ -124,6 +144,11 @@ func (b *BulletedListItemBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *BulletedListItemBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.BulletedListItemBlockType, &blockContent{Text: b.Text, Children: b.Children})
+}
+
 // NumberedListItemBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct NumberedListItemBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct NumberedListItemBlock -add-tags json,mapstructure -w -transform snakecase
@@ -134,7 +159,7 @@ type NumberedListItemBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 	Children       []Block          `json:"children" mapstructure:"children"`
 }
 
@@ -143,6 +168,11 @@ func (b *NumberedListItemBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *NumberedListItemBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.NumberListItemBlockType, &blockContent{Text: b.Text, Children: b.Children})
+}
+
 // NumberListItemBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct NumberListItemBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct NumberListItemBlock -add-tags json,mapstructure -w -transform snakecase
@@ -153,7 +183,7 @@ type NumberListItemBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 	Checked        bool             `json:"checked" mapstructure:"checked"`
 	Children       []Block          `json:"children" mapstructure:"children"`
 }
@@ -163,6 +193,11 @@ func (b *NumberListItemBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *NumberListItemBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.NumberListItemBlockType, &blockContent{Text: b.Text, Children: b.Children})
+}
+
 // ToDoBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct ToDoBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct ToDoBlock -add-tags json,mapstructure -w -transform snakecase
@@ -173,7 +208,7 @@ type ToDoBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 	Checked        bool             `json:"checked" mapstructure:"checked"`
 	Children       []Block          `json:"children" mapstructure:"children"`
 }
@@ -183,6 +218,11 @@ func (b *ToDoBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *ToDoBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.ToDoBlockType, &blockContent{Text: b.Text, Checked: &b.Checked, Children: b.Children})
+}
+
 // ToggleBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct ToggleBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct ToggleBlock -add-tags json,mapstructure -w -transform snakecase
@@ -193,7 +233,7 @@ type ToggleBlock struct {
 	CreatedTime    string           `json:"created_time" mapstructure:"created_time"`
 	LastEditedTime string           `json:"last_edited_time" mapstructure:"last_edited_time"`
 	HasChildren    bool             `json:"has_children" mapstructure:"has_children"`
-	Text           []RichTextType   `json:"text" mapstructure:"text"`
+	Text           []TextObject     `json:"text" mapstructure:"text"`
 	Children       []Block          `json:"children" mapstructure:"children"`
 }
 
@@ -202,6 +242,11 @@ func (b *ToggleBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *ToggleBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.ToggleBlockType, &blockContent{Text: b.Text, Children: b.Children})
+}
+
 // ChildPageBlock object represents the retrieve block children.
 //go:generate gomodifytags -file $GOFILE -struct ChildPageBlock -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct ChildPageBlock -add-tags json,mapstructure -w -transform snakecase
@@ -220,53 +265,76 @@ func (b *ChildPageBlock) GetType() object.BlockType {
 	return b.Type
 }
 
+// MarshalJSON encodes the block in the shape expected when writing blocks.
+func (b *ChildPageBlock) MarshalJSON() ([]byte, error) {
+	return marshalBlock(object.ChildPageBlockType, map[string]string{"title": b.Title})
+}
+
 // ListChildren blocks list.
+// All pages of the children are fetched and returned together.
 //
 // API doc: https://developers.notion.com/reference/get-block-children
-func (s *BlocksService) ListChildren(ctx context.Context, blockID string) (*ListBlockChildrenResult, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s/children", blocksPath, blockID))
+func (s *BlocksService) ListChildren(ctx context.Context, blockID BlockID) (*ListBlockChildrenResult, error) {
+	id, err := ParseBlockID(string(blockID))
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	data := map[string]interface{}{}
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return nil, err
+	result := &ListBlockChildrenResult{
+		Object:  object.List,
+		Results: []Block{},
 	}
+	cursor := ""
+	for {
+		urlStr := fmt.Sprintf("%s/%s/children", blocksPath, id)
+		if cursor != "" {
+			urlStr = fmt.Sprintf("%s?start_cursor=%s", urlStr, url.QueryEscape(cursor))
+		}
 
-	v, ok := data["results"]
-	if !ok {
-		return nil, errors.New("no results returned")
-	}
+		resp, err := s.client.get(ctx, urlStr)
+		if err != nil {
+			return nil, err
+		}
 
-	results := v.([]interface{})
-	blocks := []Block{}
-	for _, result := range results {
-		blockData, ok := result.(map[string]interface{})
-		if !ok {
-			return nil, errors.New("not block type returns")
+		data := map[string]interface{}{}
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
 		}
 
-		block, err := decodeBlock(blockData, object.BlockType(blockData["type"].(string)))
+		blocks, err := decodeBlockResults(data)
 		if err != nil {
 			return nil, err
 		}
+		result.Results = append(result.Results, blocks...)
 
-		blocks = append(blocks, block)
+		result.Object = object.Type(data["object"].(string))
+		next, _ := data["next_cursor"].(string)
+		if hasMore, _ := data["has_more"].(bool); !hasMore || next == "" {
+			break
+		}
+		cursor = next
 	}
+	s.client.warn(string(id), result.Results)
 
-	return &ListBlockChildrenResult{
-		Object:  object.Type(data["object"].(string)),
-		Results: blocks,
-	}, nil
+	return result, nil
 }
 
-// AppendChildren children block.
+type appendChildrenRequest struct {
+	Children []Block `json:"children"`
+}
+
+// AppendChildren appends children blocks to the block.
 //
-// API doc: https://developers.notion.com/reference/get-block-children
-func (s *BlocksService) AppendChildren(ctx context.Context, blockID string, children Block) (Block, error) {
-	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s/children", databasesPath, blockID), children)
+// API doc: https://developers.notion.com/reference/patch-block-children
+func (s *BlocksService) AppendChildren(ctx context.Context, blockID BlockID, children ...Block) (Block, error) {
+	id, err := ParseBlockID(string(blockID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s/children", blocksPath, id), &appendChildrenRequest{Children: children})
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +350,67 @@ func (s *BlocksService) AppendChildren(ctx context.Context, blockID string, chil
 		return nil, errors.New("not block type returns")
 	}
 
-	return decodeBlock(data, object.BlockType(blockType.(string)))
+	block, err := decodeBlock(data, object.BlockType(blockType.(string)))
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(string(id), block)
+
+	return block, nil
+}
+
+// appendChildren appends the children to the block and returns the created blocks,
+// which Notion lists in the results of the response.
+func (s *BlocksService) appendChildren(ctx context.Context, id BlockID, children []Block) ([]Block, error) {
+	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s/children", blocksPath, id), &appendChildrenRequest{Children: children})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data := map[string]interface{}{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	blocks, err := decodeBlockResults(data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(string(id), blocks)
+
+	return blocks, nil
+}
+
+// decodeBlockResults decodes the blocks in the results of a list response.
+func decodeBlockResults(data map[string]interface{}) ([]Block, error) {
+	v, ok := data["results"]
+	if !ok {
+		return nil, errors.New("no results returned")
+	}
+
+	results, ok := v.([]interface{})
+	if !ok {
+		return nil, errors.New("no results returned")
+	}
+
+	blocks := make([]Block, 0, len(results))
+	for _, r := range results {
+		blockData, ok := r.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("not block type returns")
+		}
+
+		blockType, _ := blockData["type"].(string)
+		block, err := decodeBlock(blockData, object.BlockType(blockType))
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
 }
 
 func decodeBlock(data map[string]interface{}, blockType object.BlockType) (Block, error) {
@@ -307,15 +435,69 @@ func decodeBlock(data map[string]interface{}, blockType object.BlockType) (Block
 		b = &ToDoBlock{}
 	case object.ChildPageBlockType:
 		b = &ChildPageBlock{}
-	case object.UnsupportedBlockType:
-		return nil, fmt.Errorf("%s block type not supported", blockType)
 	default:
-		return nil, fmt.Errorf("%s block type not supported", blockType)
+		return newUnknownBlock(data, blockType)
 	}
 
-	if err := mapstructure.Decode(data, &b); err != nil {
+	// Block contents are nested under the block type key.
+	fields := map[string]interface{}{}
+	for k, v := range data {
+		fields[k] = v
+	}
+	if content, ok := data[string(blockType)].(map[string]interface{}); ok {
+		for k, v := range content {
+			fields[k] = v
+		}
+	}
+
+	if err := decode(fields, &b); err != nil {
 		return nil, err
 	}
 
 	return b, nil
 }
+
+// blockID returns the ID of the block and whether it has children.
+func blockID(b Block) (string, bool) {
+	switch b := b.(type) {
+	case *ParagraphBlock:
+		return b.ID, b.HasChildren
+	case *HeadingOneBlock:
+		return b.ID, b.HasChildren
+	case *HeadingTwoBlock:
+		return b.ID, b.HasChildren
+	case *HeadingThreeBlock:
+		return b.ID, b.HasChildren
+	case *BulletedListItemBlock:
+		return b.ID, b.HasChildren
+	case *NumberedListItemBlock:
+		return b.ID, b.HasChildren
+	case *NumberListItemBlock:
+		return b.ID, b.HasChildren
+	case *ToDoBlock:
+		return b.ID, b.HasChildren
+	case *ToggleBlock:
+		return b.ID, b.HasChildren
+	case *ChildPageBlock:
+		return b.ID, b.HasChildren
+	case *UnknownBlock:
+		return b.ID, b.HasChildren
+	}
+
+	return "", false
+}
+
+// blockContent is the type-keyed content of a block written to the API.
+type blockContent struct {
+	Text     []TextObject `json:"text"`
+	Checked  *bool        `json:"checked,omitempty"`
+	Children []Block      `json:"children,omitempty"`
+}
+
+func marshalBlock(blockType object.BlockType, content interface{}) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"object":          object.Block,
+		"type":            blockType,
+		string(blockType): content,
+	})
+}
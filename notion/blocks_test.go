@@ -131,7 +131,7 @@ func TestDatabasesService_ListChildren(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id   string
+		id   BlockID
 		want *ListBlockChildrenResult
 	}{
 		"ok": {
@@ -201,7 +201,7 @@ func TestDatabasesService_AppendChildren(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id    string
+		id    BlockID
 		input Block
 		want  Block
 	}{
@@ -215,17 +215,29 @@ func TestDatabasesService_AppendChildren(t *testing.T) {
 				CreatedTime:    "2020-03-17T19:10:04.968Z",
 				LastEditedTime: "2020-03-17T21:49:37.913Z",
 				HasChildren:    true,
+				Text: []TextObject{
+					{
+						PlainText:   "Recipes",
+						Annotations: &Annotations{Bold: true, Color: "default"},
+						Type:        "text",
+						Text:        &Text{Content: "Recipes"},
+					},
+				},
 			},
 		},
 	}
 
 	for n, tc := range tcs {
 		t.Run(n, func(t *testing.T) {
-			mux.HandleFunc(fmt.Sprintf("/%s/%s/children", databasesPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/children", blocksPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
 				if r.Header.Get(notionVersionHeader) == "" {
 					t.Fatalf("no notion version header to request")
 				}
 
+				if r.Method != http.MethodPatch {
+					t.Fatalf("unexpected method: %s", r.Method)
+				}
+
 				fmt.Fprint(w, getAppendChildrenJSON())
 			})
 
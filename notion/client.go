@@ -49,6 +49,9 @@ type Client struct {
 	BaseURL     *url.URL
 	version     string
 
+	warningHandler func(Warning)
+	schemas        *schemaCache
+
 	Blocks    *BlocksService
 	Databases *DatabasesService
 	Pages     *PagesService
@@ -0,0 +1,95 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestPagesService_UpdatePropertiesIf(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newFakeWorkspace(t, mux)
+	id := "b55c9c91-384d-452b-81db-d1ef79372b75"
+	w.addPage(id, map[string]interface{}{"type": "workspace", "workspace": true}, `{
+		"Points": {"id": "Pts", "type": "number", "number": 3}
+	}`)
+	w.pages[id]["last_edited_time"] = "2021-05-12T10:00:00.000Z"
+
+	page, err := client.Pages.Get(context.Background(), PageID(id))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	version := page.Version()
+
+	ureq := &UpdatePageRequest{Properties: map[string]PropertyValue{
+		"Points": NewNumber(5),
+	}}
+	if _, err := client.Pages.UpdatePropertiesIf(context.Background(), PageID(id), version, ureq); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	// The first update changed the page within the same minute, so last_edited_time
+	// is unchanged but the same version is now stale.
+	_, err = client.Pages.UpdatePropertiesIf(context.Background(), PageID(id), version, ureq)
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+
+	_, err = client.Pages.UpdatePropertiesIf(context.Background(), PageID(id), PageVersion{Hash: version.Hash}, ureq)
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+
+	if points, _ := conflict.Current.Number("Points"); points == nil || *points != 5 {
+		t.Fatalf("conflict does not hold the current page: %v", points)
+	}
+
+	w.pages[id]["last_edited_time"] = "2021-05-12T10:05:00.000Z"
+	_, err = client.Pages.UpdatePropertiesIf(context.Background(), PageID(id), PageVersion{LastEditedTime: version.LastEditedTime}, ureq)
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+}
+
+func TestPropertiesHash(t *testing.T) {
+	a := decodePage(t, getTaskPageJSON())
+	b := decodePage(t, getTaskPageJSON())
+
+	// Splitting the title differently does not change the value.
+	b.Properties["Name"] = &PageTitleProperty{
+		Type:  object.TitlePropertyType,
+		Title: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Write docs"}}},
+	}
+	if PropertiesHash(a.Properties) != PropertiesHash(b.Properties) {
+		t.Fatalf("hash changed for the same values")
+	}
+
+	// Uploaded files get a new signed URL on every read.
+	a.Properties["Attachments"] = &FilesProperty{Type: object.FilesPropertyType, Files: []File{
+		{Name: "spec.pdf", Type: NotionFileType, File: &NotionFile{URL: "https://s3.us-west-2.amazonaws.com/spec.pdf?X-Amz-Signature=a"}},
+	}}
+	b.Properties["Attachments"] = &FilesProperty{Type: object.FilesPropertyType, Files: []File{
+		{Name: "spec.pdf", Type: NotionFileType, File: &NotionFile{URL: "https://s3.us-west-2.amazonaws.com/spec.pdf?X-Amz-Signature=b"}},
+	}}
+	if PropertiesHash(a.Properties) != PropertiesHash(b.Properties) {
+		t.Fatalf("hash changed for the same uploaded files")
+	}
+
+	b.Properties["Attachments"] = &FilesProperty{Type: object.FilesPropertyType, Files: []File{
+		NewExternalFile("spec.pdf", "https://example.org/spec.pdf"),
+	}}
+	if PropertiesHash(a.Properties) == PropertiesHash(b.Properties) {
+		t.Fatalf("hash not changed for different files")
+	}
+	b.Properties["Attachments"] = a.Properties["Attachments"]
+
+	b.Properties["Points"] = NewNumber(4)
+	if PropertiesHash(a.Properties) == PropertiesHash(b.Properties) {
+		t.Fatalf("hash not changed for different values")
+	}
+}
@@ -2,6 +2,7 @@ package notion
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -81,7 +82,7 @@ func getDatabaseSON() string {
 			"id": "p:sC",
 			"type": "formula",
 			"formula": {
-			  "value": "if(prop(\"In stock\"), 0, prop(\"Price\"))"
+			  "expression": "if(prop(\"In stock\"), 0, prop(\"Price\"))"
 			}
 		  },
 		  "Last ordered": {
@@ -104,7 +105,6 @@ func getDatabaseSON() string {
 			"type": "multi_select",
 			"multi_select": {
 			  "options": [
-				[
 				  {
 					"id": "d209b920-212c-4040-9d4a-bdf349dd8b2a",
 					"name": "Duc Loi Market",
@@ -125,7 +125,6 @@ func getDatabaseSON() string {
 					"name": "Gus's Community Market",
 					"color": "yellow"
 				  }
-				]
 			  ]
 			}
 		  },
@@ -148,7 +147,7 @@ func TestDatabasesService_Get(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id   string
+		id   DatabaseID
 		want *Database
 	}{
 		"ok": {
@@ -254,7 +253,7 @@ func TestDatabasesService_Query(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id    string
+		id    DatabaseID
 		query *DatabaseQuery
 		want  *QueryDatabaseResults
 	}{
@@ -361,3 +360,195 @@ func TestDatabasesService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseQuery_MarshalJSON(t *testing.T) {
+	zero := 0
+	query := &DatabaseQuery{
+		Filter: map[CompoundFilterType]FilterObject{
+			AndFilter: []FilterObject{
+				&StatusFilter{Property: "Stage", Equals: "In review"},
+				&UniqueIDFilter{Property: "Task ID", GreaterThan: &zero},
+			},
+		},
+		Sorts: []Sort{{Property: "Task ID", Direction: Descending}},
+	}
+
+	b, err := json.Marshal(query)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `{"filter":{"and":[{"property":"Stage","equals":"In review"},{"property":"Task ID","greater_than":0}]},"sorts":[{"property":"Task ID","direction":"descending"}]}`
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDatabasesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	parentID := PageID("98ad959b-2b6a-4774-80ee-00246fb0ea9b")
+	mux.HandleFunc(fmt.Sprintf("/%s", databasesPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+
+		var body interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("invalid body: %v", err)
+		}
+
+		var want interface{}
+		if err := json.Unmarshal([]byte(`{
+			"parent": {"type": "page_id", "page_id": "98ad959b-2b6a-4774-80ee-00246fb0ea9b"},
+			"title": [{"type": "text", "text": {"content": "Grocery List"}}],
+			"properties": {
+				"Name": {"title": {}},
+				"Price": {"number": {"format": "dollar"}},
+				"Food group": {"select": {"options": [{"name": "Vegetable", "color": "green"}, {"name": "Fruit"}]}},
+				"Meals": {"relation": {"database_id": "668d797c-76fa-4934-9b05-ad288df2d136"}},
+				"Number of meals": {"rollup": {"relation_property_name": "Meals", "rollup_property_name": "Name", "function": "count"}},
+				"Cost": {"formula": {"expression": "prop(\"Price\") * 2"}},
+				"Stage": {"status": {}},
+				"Tags": {"multi_select": {}}
+			}
+		}`), &want); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(body, want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		fmt.Fprint(w, `{
+			"object": "database",
+			"id": "bc1211ca-e3f1-4939-ae34-5260b16f627c",
+			"title": [{"type": "text", "text": {"content": "Grocery List"}, "plain_text": "Grocery List"}],
+			"properties": {
+				"Name": {"id": "title", "name": "Name", "type": "title", "title": {}},
+				"Price": {"id": "cU^N", "name": "Price", "type": "number", "number": {"format": "dollar"}}
+			}
+		}`)
+	})
+
+	got, err := client.Databases.Create(context.Background(), parentID, "Grocery List", map[string]PropertySchema{
+		"Name":  &TitlePropertySchema{},
+		"Price": &NumberPropertySchema{Number: NumberConfig{Format: DollarNumberFormat}},
+		"Food group": &SelectPropertySchema{Select: SelectConfig{Options: []SelectOption{
+			{Name: "Vegetable", Color: GreenColor},
+			{Name: "Fruit"},
+		}}},
+		"Meals": &RelationPropertySchema{Relation: RelationConfig{DatabaseID: "668d797c-76fa-4934-9b05-ad288df2d136"}},
+		"Number of meals": &RollupPropertySchema{Rollup: RollupConfig{
+			RelationPropertyName: "Meals",
+			RollupPropertyName:   "Name",
+			Function:             "count",
+		}},
+		"Cost":  &FormulaPropertySchema{Formula: FormulaConfig{Expression: `prop("Price") * 2`}},
+		"Stage": &StatusPropertySchema{Status: StatusConfig{Groups: []StatusGroup{{Name: "To-do"}}}},
+		"Tags":  &MultiSelectPropertySchema{},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := &Database{
+		Object: object.Database,
+		ID:     "bc1211ca-e3f1-4939-ae34-5260b16f627c",
+		Title:  []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Grocery List"}, PlainText: "Grocery List"}},
+		Properties: map[string]PropertySchema{
+			"Name":  &TitlePropertySchema{Type: object.TitlePropertyType, ID: "title", Name: "Name"},
+			"Price": &NumberPropertySchema{Type: object.NumberPropertyType, ID: "cU^N", Name: "Price", Number: NumberConfig{Format: DollarNumberFormat}},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDatabasesService_Create_noTitle(t *testing.T) {
+	client := NewClient(testAccessKey)
+
+	_, err := client.Databases.Create(context.Background(), "98ad959b-2b6a-4774-80ee-00246fb0ea9b", "Grocery List", map[string]PropertySchema{
+		"Price": &NumberPropertySchema{},
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestDatabasesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	databaseID := DatabaseID("668d797c-76fa-4934-9b05-ad288df2d136")
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, databaseID), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+
+		var body interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("invalid body: %v", err)
+		}
+
+		var want interface{}
+		if err := json.Unmarshal([]byte(`{
+			"title": [{"type": "text", "text": {"content": "Groceries"}}],
+			"properties": {
+				"cU^N": {"name": "Unit price"},
+				"Food group": {"select": {"options": [{"name": "Vegetable", "color": "green"}, {"name": "Fruit", "color": "red"}]}},
+				"Stores": {"name": "Store", "text": {}},
+				"Calories": {"number": {"format": "number"}},
+				"Stock": {"select": {}},
+				"Tags": {"multi_select": {}},
+				"Meals": null
+			}
+		}`), &want); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(body, want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		fmt.Fprintf(w, `{
+			"object": "database",
+			"id": "%s",
+			"properties": {
+				"Name": {"id": "title", "name": "Name", "type": "title", "title": {}},
+				"Unit price": {"id": "cU^N", "name": "Unit price", "type": "number", "number": {"format": "dollar"}}
+			}
+		}`, databaseID)
+	})
+
+	got, err := client.Databases.Update(context.Background(), databaseID, &UpdateDatabaseRequest{
+		Title: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Groceries"}}},
+		Properties: map[string]*PropertyPatch{
+			"cU^N": {Name: "Unit price"},
+			"Food group": {Schema: &SelectPropertySchema{Select: SelectConfig{Options: []SelectOption{
+				{Name: "Vegetable", Color: GreenColor},
+				{Name: "Fruit", Color: RedColor},
+			}}}},
+			"Stores":   {Name: "Store", Schema: &TextPropertySchema{}},
+			"Calories": {Schema: &NumberPropertySchema{Number: NumberConfig{Format: NumberNumberFormat}}},
+			"Stock":    {Schema: &SelectPropertySchema{}},
+			"Tags":     {Schema: &MultiSelectPropertySchema{}},
+			"Meals":    nil,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got.PropertyID("Unit price"), "cU^N"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+	if diff := cmp.Diff(got.Property("cU^N"), got.Properties["Unit price"]); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+	if got.PropertyID("Price") != "" || got.Property("Price") != nil {
+		t.Fatalf("unexpected property: Price")
+	}
+}
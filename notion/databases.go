@@ -24,18 +24,34 @@ type DatabasesService service
 //go:generate gomodifytags -file $GOFILE -struct Database -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Database -add-tags json,mapstructure -w -transform snakecase
 type Database struct {
-	Object         object.Type         `json:"object" mapstructure:"object"`
-	ID             string              `json:"id" mapstructure:"id"`
-	CreatedTime    string              `json:"created_time" mapstructure:"created_time"`
-	LastEditedTime string              `json:"last_edited_time" mapstructure:"last_edited_time"`
-	Title          []TextObject        `json:"title" mapstructure:"title"`
-	Properties     map[string]Property `json:"properties" mapstructure:"properties"`
+	Object         object.Type               `json:"object" mapstructure:"object"`
+	ID             string                    `json:"id" mapstructure:"id"`
+	CreatedTime    string                    `json:"created_time" mapstructure:"created_time"`
+	LastEditedTime string                    `json:"last_edited_time" mapstructure:"last_edited_time"`
+	Title          []TextObject              `json:"title" mapstructure:"title"`
+	Properties     map[string]PropertySchema `json:"properties" mapstructure:"properties"`
 }
 
 func (db *Database) GetObject() object.Type {
 	return db.Object
 }
 
+// Property returns the property with the name or ID, or nil when the database has none.
+func (db *Database) Property(key string) PropertySchema {
+	return schemaProperty(db.Properties, key)
+}
+
+// PropertyID returns the ID of the property with the name, or an empty string when the database has none.
+// Unlike names, IDs do not change when properties are renamed.
+func (db *Database) PropertyID(name string) string {
+	p, ok := db.Properties[name]
+	if !ok {
+		return ""
+	}
+
+	return p.GetID()
+}
+
 //go:generate gomodifytags -file $GOFILE -struct database -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct database -add-tags json,mapstructure -w -transform snakecase
 type database struct {
@@ -50,8 +66,74 @@ type database struct {
 // Get retrieves database by database ID.
 //
 // API doc: https://developers.notion.com/reference/get-database
-func (s *DatabasesService) Get(ctx context.Context, databaseID string) (*Database, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", databasesPath, databaseID))
+func (s *DatabasesService) Get(ctx context.Context, databaseID DatabaseID) (*Database, error) {
+	id, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", databasesPath, id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data := database{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	db, err := convDatabase(&data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(db.ID, db)
+
+	return db, nil
+}
+
+//go:generate gomodifytags -file $GOFILE -struct createDatabaseRequest -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct createDatabaseRequest -add-tags json,mapstructure -w -transform snakecase
+type createDatabaseRequest struct {
+	Parent     *PageParent            `json:"parent" mapstructure:"parent"`
+	Title      []TextObject           `json:"title" mapstructure:"title"`
+	Properties map[string]interface{} `json:"properties" mapstructure:"properties"`
+}
+
+// Create creates a database in the parent page, with the properties of the schema keyed by name.
+// The schema needs exactly one title property.
+//
+// API doc: https://developers.notion.com/reference/create-a-database
+func (s *DatabasesService) Create(ctx context.Context, parentPageID PageID, title string, schema map[string]PropertySchema) (*Database, error) {
+	id, err := ParsePageID(string(parentPageID))
+	if err != nil {
+		return nil, err
+	}
+
+	titles := 0
+	properties := map[string]interface{}{}
+	for name, p := range schema {
+		if p.GetType() == object.TitlePropertyType {
+			titles++
+		}
+
+		config, err := propertySchemaConfig(p)
+		if err != nil {
+			return nil, err
+		}
+		properties[name] = map[string]interface{}{string(p.GetType()): config}
+	}
+	if titles != 1 {
+		return nil, fmt.Errorf("database schema has %d title properties, not 1", titles)
+	}
+
+	req := &createDatabaseRequest{
+		Parent:     &PageParent{Type: object.PageParentType, PageID: id.String()},
+		Title:      []TextObject{{Type: TextRichTextType, Text: &Text{Content: title}}},
+		Properties: properties,
+	}
+
+	resp, err := s.client.post(ctx, databasesPath, req)
 	if err != nil {
 		return nil, err
 	}
@@ -62,17 +144,132 @@ func (s *DatabasesService) Get(ctx context.Context, databaseID string) (*Databas
 		return nil, err
 	}
 
-	return convDatabase(&data)
+	db, err := convDatabase(&data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(db.ID, db)
+
+	return db, nil
+}
+
+// UpdateDatabaseRequest is a change of the title and the schema of a database.
+type UpdateDatabaseRequest struct {
+	// Title replaces the title of the database when it is set.
+	Title []TextObject `json:"title,omitempty" mapstructure:"title"`
+	// Properties are keyed by property name or ID. Properties which do not exist yet are added,
+	// and a nil patch removes the property.
+	Properties map[string]*PropertyPatch `json:"properties,omitempty" mapstructure:"properties"`
+}
+
+// PropertyPatch is a change of a database property.
+type PropertyPatch struct {
+	// Name renames the property when it is set.
+	Name string
+	// Schema changes the type or the configuration of the property when it is set,
+	// such as the options of a select property.
+	Schema PropertySchema
+}
+
+// MarshalJSON encodes the patch as a property schema object.
+func (p *PropertyPatch) MarshalJSON() ([]byte, error) {
+	v := map[string]interface{}{}
+	if p.Name != "" {
+		v["name"] = p.Name
+	}
+
+	if p.Schema != nil {
+		config, err := propertySchemaConfig(p.Schema)
+		if err != nil {
+			return nil, err
+		}
+		v[string(p.Schema.GetType())] = config
+	}
+
+	return json.Marshal(v)
+}
+
+// Update changes the title and the properties of the database.
+// The cached schema of the database used for validation is dropped.
+//
+// API doc: https://developers.notion.com/reference/update-a-database
+func (s *DatabasesService) Update(ctx context.Context, databaseID DatabaseID, ureq *UpdateDatabaseRequest) (*Database, error) {
+	id, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s", databasesPath, id), ureq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	s.client.forgetSchema(id)
+
+	data := database{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	db, err := convDatabase(&data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(db.ID, db)
+
+	return db, nil
+}
+
+// propertySchemaConfig returns the configuration of the property, which is the value
+// keyed by the property type in the schema object, in the shape Notion accepts when writing:
+// unset options are left out rather than sent as null, and status groups, which can not
+// be written through the API, are never sent.
+func propertySchemaConfig(p PropertySchema) (interface{}, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	v := map[string]interface{}{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	if config, ok := v[string(p.GetType())]; ok && config != nil {
+		if m, ok := config.(map[string]interface{}); ok {
+			if m["options"] == nil {
+				delete(m, "options")
+			}
+			delete(m, "groups")
+		}
+		return config, nil
+	}
+
+	return map[string]interface{}{}, nil
 }
 
 // DatabaseQuery is a query for database
 type DatabaseQuery struct {
-	Filter      map[CompoundFilterType]FilterObject `json:"filter,omitempty" mapstructure:"filter"`
-	Sorts       []Sort                              `json:"sorts,omitempty" mapstructure:"sort"`
-	StartCursor string                              `json:"start_cursor,omitempty" mapstructure:"start_cursor"`
-	PageSize    int32                               `json:"page_size,omitempty" mapstructure:"page_size"`
+	// Filter is a *QueryFilter built with Where, And and Or. Maps of compound filters
+	// (map[CompoundFilterType]FilterObject) holding the filter structs below are also accepted.
+	Filter      FilterObject `json:"filter,omitempty" mapstructure:"filter"`
+	Sorts       []Sort       `json:"sorts,omitempty" mapstructure:"sort"`
+	StartCursor string       `json:"start_cursor,omitempty" mapstructure:"start_cursor"`
+	PageSize    int32        `json:"page_size,omitempty" mapstructure:"page_size"`
+}
+
+// MarshalJSON encodes the query. A nil *QueryFilter is left out like a nil Filter.
+func (q *DatabaseQuery) MarshalJSON() ([]byte, error) {
+	type query DatabaseQuery
+	req := query(*q)
+	if f, ok := req.Filter.(*QueryFilter); ok && f == nil {
+		req.Filter = nil
+	}
+
+	return json.Marshal(&req)
 }
 
+// FilterObject is a filter of a database query.
 type FilterObject interface{}
 
 // TextFilter filters text properties.
@@ -101,6 +298,18 @@ type NumberFilter struct {
 	IsNotEmpty           bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
+// UniqueIDFilter filters unique ID properties by their number.
+// The operands are pointers, so that 0 can be compared against.
+type UniqueIDFilter struct {
+	Property             string `json:"property" mapstructure:"property"`
+	Equals               *int   `json:"equals,omitempty" mapstructure:"equals"`
+	DoesNotEqual         *int   `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
+	GreaterThan          *int   `json:"greater_than,omitempty" mapstructure:"greater_than"`
+	LessThan             *int   `json:"less_than,omitempty" mapstructure:"less_than"`
+	GreaterThanOrEqualTo *int   `json:"greater_than_or_equal_to,omitempty" mapstructure:"greater_than_or_equal_to"`
+	LessThanOrEqualTo    *int   `json:"less_than_or_equal_to,omitempty" mapstructure:"less_than_or_equal_to"`
+}
+
 // CheckboxFilter filters object properties.
 type CheckboxFilter struct {
 	Property    string `json:"property" mapstructure:"property"`
@@ -117,6 +326,15 @@ type SelectFilter struct {
 	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
+// StatusFilter filters status properties.
+type StatusFilter struct {
+	Property    string `json:"property" mapstructure:"property"`
+	Equals      string `json:"equals,omitempty" mapstructure:"equals"`
+	DoeNotEqual string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
+	IsEmpty     bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+}
+
 // MultiSelectFilter filters select properties.
 type MultiSelectFilter struct {
 	Property    string `json:"property" mapstructure:"property"`
@@ -129,17 +347,17 @@ type MultiSelectFilter struct {
 // DataFilter filters data properties.
 type DataFilter struct {
 	Property   string      `json:"property" mapstructure:"property"`
-	Equals     string      `json:"equals,omitempty" mapstructure:"equals"`
-	Before     string      `json:"before,omitempty" mapstructure:"before"`
-	After      string      `json:"after,omitempty" mapstructure:"after"`
-	OnOrBefore string      `json:"on_or_before,omitempty" mapstructure:"on_or_before"`
+	Equals     *DateTime   `json:"equals,omitempty" mapstructure:"equals"`
+	Before     *DateTime   `json:"before,omitempty" mapstructure:"before"`
+	After      *DateTime   `json:"after,omitempty" mapstructure:"after"`
+	OnOrBefore *DateTime   `json:"on_or_before,omitempty" mapstructure:"on_or_before"`
 	IsEmpty    bool        `json:"is_empty,omitempty" mapstructure:"is_empty"`
 	IsNotEmpty bool        `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
-	OnOrAfter  string      `json:"on_or_after,omitempty" mapstructure:"on_or_after"`
-	PassWeek   interface{} `json:"pass_week,omitempty" mapstructure:"pass_week"`
-	PassMonth  interface{} `json:"pass_month,omitempty" mapstructure:"pass_month"`
-	PassYear   interface{} `json:"pass_year,omitempty" mapstructure:"pass_year"`
-	NextWeek   interface{} `json:"next_weeb,omitempty" mapstructure:"next_weeb"`
+	OnOrAfter  *DateTime   `json:"on_or_after,omitempty" mapstructure:"on_or_after"`
+	PassWeek   interface{} `json:"past_week,omitempty" mapstructure:"past_week"`
+	PassMonth  interface{} `json:"past_month,omitempty" mapstructure:"past_month"`
+	PassYear   interface{} `json:"past_year,omitempty" mapstructure:"past_year"`
+	NextWeek   interface{} `json:"next_week,omitempty" mapstructure:"next_week"`
 	NextMonth  interface{} `json:"next_month,omitempty" mapstructure:"next_month"`
 	NextYear   interface{} `json:"next_year,omitempty" mapstructure:"next_year"`
 }
@@ -169,13 +387,13 @@ type RelationFilter struct {
 	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
-// FormulaFilter filters select properties.
+// FormulaFilter filters formula properties.
 type FormulaFilter struct {
 	Property string          `json:"property" mapstructure:"property"`
-	Text     *TextFilter     `json:"equals,omitempty" mapstructure:"equals"`
-	Checkbox *CheckboxFilter `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	Number   *NumberFilter   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	Date     *DataFilter     `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Text     *TextFilter     `json:"text,omitempty" mapstructure:"text"`
+	Checkbox *CheckboxFilter `json:"checkbox,omitempty" mapstructure:"checkbox"`
+	Number   *NumberFilter   `json:"number,omitempty" mapstructure:"number"`
+	Date     *DataFilter     `json:"date,omitempty" mapstructure:"date"`
 }
 
 // CompoundFilterType is a type for compound filters.
@@ -206,8 +424,13 @@ type queryDatabaseResults struct {
 // Query queries a database.
 //
 // API doc: https://developers.notion.com/reference/post-databases-query
-func (s *DatabasesService) Query(ctx context.Context, databaseID string, query *DatabaseQuery) (*QueryDatabaseResults, error) {
-	resp, err := s.client.post(ctx, fmt.Sprintf("%s/%s/query", databasesPath, databaseID), query)
+func (s *DatabasesService) Query(ctx context.Context, databaseID DatabaseID, query *DatabaseQuery) (*QueryDatabaseResults, error) {
+	id, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.post(ctx, fmt.Sprintf("%s/%s/query", databasesPath, id), query)
 	if err != nil {
 		return nil, err
 	}
@@ -246,6 +469,7 @@ func (s *DatabasesService) Query(ctx context.Context, databaseID string, query *
 			objects = append(objects, page)
 		}
 	}
+	s.client.warn("", objects)
 
 	return &QueryDatabaseResults{
 		HasMore:    data.HasMore,
@@ -299,7 +523,7 @@ func (s *DatabasesService) List(ctx context.Context) (*ListDatabaseResponse, err
 }
 
 func convDatabase(data *database) (*Database, error) {
-	properties, err := convProperties(data.Properties)
+	properties, err := convPropertySchemas(data.Properties)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,199 @@
+package notion
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout          = "2006-01-02"
+	localDateTimeLayout = "2006-01-02T15:04:05.000"
+	dateTimeLayout      = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// DateTime is a point of a Notion date. Notion dates are either a date or a date time:
+// when HasTime is false, only the year, month and day of Time are meaningful.
+type DateTime struct {
+	Time    time.Time
+	HasTime bool
+}
+
+// ParseDateTime parses a date ("2006-01-02") or a date time in ISO 8601.
+// Date times without an offset are in UTC.
+func ParseDateTime(s string) (DateTime, error) {
+	return parseDateTimeIn(s, time.UTC)
+}
+
+// parseDateTimeIn parses a date or a date time. Dates, and date times without an offset, are in loc.
+func parseDateTimeIn(s string, loc *time.Location) (DateTime, error) {
+	if t, err := time.ParseInLocation(dateLayout, s, loc); err == nil {
+		return DateTime{Time: t}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return DateTime{Time: t, HasTime: true}, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02T15:04:05.999999999", s, loc)
+	if err != nil {
+		return DateTime{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	return DateTime{Time: t, HasTime: true}, nil
+}
+
+// IsZero reports whether the date is not set.
+func (d DateTime) IsZero() bool {
+	return d.Time.IsZero()
+}
+
+// String returns the date in the ISO 8601 format Notion uses.
+func (d DateTime) String() string {
+	if !d.HasTime {
+		return d.Time.Format(dateLayout)
+	}
+
+	return d.Time.Format(dateTimeLayout)
+}
+
+// MarshalText encodes the date in the ISO 8601 format Notion uses.
+func (d DateTime) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes a date or a date time.
+func (d *DateTime) UnmarshalText(b []byte) error {
+	v, err := ParseDateTime(string(b))
+	if err != nil {
+		return err
+	}
+
+	*d = v
+	return nil
+}
+
+// Date object represents a Notion date: a single date or date time, or a range of them.
+//
+// API doc: https://developers.notion.com/reference/property-value-object#date-property-values
+type Date struct {
+	Start DateTime
+	// End is the end of the range, or nil when the date is not a range.
+	End *DateTime
+	// TimeZone is the IANA time zone name, such as "America/New_York".
+	// When it is set, the date times are sent as wall clock times in the time zone
+	// instead of with an offset.
+	TimeZone string
+}
+
+// NewDate returns the date of t, without a time.
+func NewDate(t time.Time) *Date {
+	return &Date{Start: DateTime{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}}
+}
+
+// NewDateTime returns a date with the date and time of t.
+func NewDateTime(t time.Time) *Date {
+	return &Date{Start: DateTime{Time: t, HasTime: true}}
+}
+
+// Until returns a copy of the date ranging to end. The end keeps a time only when the start has one.
+func (d Date) Until(end time.Time) *Date {
+	date := d
+	if d.Start.HasTime {
+		date.End = &DateTime{Time: end, HasTime: true}
+	} else {
+		date.End = &NewDate(end).Start
+	}
+
+	return &date
+}
+
+// IsRange reports whether the date has an end.
+func (d Date) IsRange() bool {
+	return d.End != nil && !d.End.IsZero()
+}
+
+// String returns the date in ISO 8601, with the start and end separated by a slash for ranges.
+func (d Date) String() string {
+	if !d.IsRange() {
+		return d.Start.String()
+	}
+
+	return d.Start.String() + "/" + d.End.String()
+}
+
+// zoneLocation loads the IANA time zone. Hosts without zoneinfo, such as scratch images,
+// can not load it; times are then read and written as UTC wall clock times, so that they
+// keep the times Notion shows along with the name of the zone.
+func zoneLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+type date struct {
+	Start    string  `json:"start"`
+	End      *string `json:"end"`
+	TimeZone *string `json:"time_zone"`
+}
+
+// MarshalJSON encodes the date object.
+func (d Date) MarshalJSON() ([]byte, error) {
+	format := DateTime.String
+	if d.TimeZone != "" {
+		loc := zoneLocation(d.TimeZone)
+		format = func(dt DateTime) string {
+			if !dt.HasTime {
+				return dt.String()
+			}
+			return dt.Time.In(loc).Format(localDateTimeLayout)
+		}
+	}
+
+	v := date{Start: format(d.Start)}
+	if d.IsRange() {
+		end := format(*d.End)
+		v.End = &end
+	}
+	if d.TimeZone != "" {
+		v.TimeZone = &d.TimeZone
+	}
+
+	return json.Marshal(&v)
+}
+
+// UnmarshalJSON decodes the date object.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	v := date{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	loc := time.UTC
+	d.TimeZone = ""
+	if v.TimeZone != nil && *v.TimeZone != "" {
+		loc = zoneLocation(*v.TimeZone)
+		d.TimeZone = *v.TimeZone
+	}
+
+	start, err := parseDateTimeIn(strings.TrimSpace(v.Start), loc)
+	if err != nil {
+		return err
+	}
+	d.Start = start
+
+	d.End = nil
+	if v.End != nil && *v.End != "" {
+		end, err := parseDateTimeIn(strings.TrimSpace(*v.End), loc)
+		if err != nil {
+			return err
+		}
+		d.End = &end
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseDateTime(t *testing.T) {
+	tcs := map[string]struct {
+		input   string
+		want    DateTime
+		wantErr bool
+	}{
+		"date": {
+			input: "2021-05-11",
+			want:  DateTime{Time: time.Date(2021, 5, 11, 0, 0, 0, 0, time.UTC)},
+		},
+		"date time": {
+			input: "2021-05-11T11:00:00.000-04:00",
+			want:  DateTime{Time: time.Date(2021, 5, 11, 11, 0, 0, 0, time.FixedZone("", -4*60*60)), HasTime: true},
+		},
+		"date time without offset": {
+			input: "2021-05-11T11:00:00",
+			want:  DateTime{Time: time.Date(2021, 5, 11, 11, 0, 0, 0, time.UTC), HasTime: true},
+		},
+		"invalid": {
+			input:   "May 11",
+			wantErr: true,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := ParseDateTime(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if !got.Time.Equal(tc.want.Time) || got.HasTime != tc.want.HasTime {
+				t.Fatalf("got:%v want:%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+
+	tcs := map[string]struct {
+		date   Date
+		json   string
+		string string
+	}{
+		"date": {
+			date:   *NewDate(time.Date(2021, 5, 11, 15, 0, 0, 0, time.UTC)),
+			json:   `{"start":"2021-05-11","end":null,"time_zone":null}`,
+			string: "2021-05-11",
+		},
+		"date range": {
+			date:   *NewDate(time.Date(2021, 5, 11, 0, 0, 0, 0, time.UTC)).Until(time.Date(2021, 5, 13, 0, 0, 0, 0, time.UTC)),
+			json:   `{"start":"2021-05-11","end":"2021-05-13","time_zone":null}`,
+			string: "2021-05-11/2021-05-13",
+		},
+		"date time": {
+			date:   *NewDateTime(time.Date(2021, 5, 11, 11, 0, 0, 0, time.FixedZone("", -4*60*60))),
+			json:   `{"start":"2021-05-11T11:00:00.000-04:00","end":null,"time_zone":null}`,
+			string: "2021-05-11T11:00:00.000-04:00",
+		},
+		"time zone": {
+			date: Date{
+				Start:    DateTime{Time: time.Date(2021, 5, 13, 9, 0, 0, 0, tokyo), HasTime: true},
+				End:      &DateTime{Time: time.Date(2021, 5, 13, 1, 30, 0, 0, time.UTC), HasTime: true},
+				TimeZone: "Asia/Tokyo",
+			},
+			json:   `{"start":"2021-05-13T09:00:00.000","end":"2021-05-13T10:30:00.000","time_zone":"Asia/Tokyo"}`,
+			string: "2021-05-13T09:00:00.000+09:00/2021-05-13T01:30:00.000Z",
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			b, err := json.Marshal(tc.date)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(string(b), tc.json); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(tc.date.String(), tc.string); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			got := Date{}
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if !got.Start.Time.Equal(tc.date.Start.Time) || got.IsRange() != tc.date.IsRange() || got.TimeZone != tc.date.TimeZone {
+				t.Fatalf("got:%v want:%v", got, tc.date)
+			}
+			if got.IsRange() && !got.End.Time.Equal(tc.date.End.Time) {
+				t.Fatalf("got end:%v want:%v", got.End, tc.date.End)
+			}
+		})
+	}
+}
+
+func TestPagesService_Get_unknownTimeZone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	id := PageID("b55c9c91-384d-452b-81db-d1ef79372b75")
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", pagesPath, id), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "object": "page",
+  "id": "b55c9c91-384d-452b-81db-d1ef79372b75",
+  "parent": {"type": "workspace", "workspace": true},
+  "properties": {
+    "Due": {
+      "id": "due",
+      "type": "date",
+      "date": {"start": "2021-05-13T09:00:00.000", "end": null, "time_zone": "Mars/Olympus_Mons"}
+    }
+  }
+}`)
+	})
+
+	got, err := client.Pages.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	d := got.Properties["Due"].(*DateProperty).Date
+	if diff := cmp.Diff(d.TimeZone, "Mars/Olympus_Mons"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(string(b), `{"start":"2021-05-13T09:00:00.000","end":null,"time_zone":"Mars/Olympus_Mons"}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDataFilter_MarshalJSON(t *testing.T) {
+	after, err := ParseDateTime("2021-05-10")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(&DataFilter{Property: "Due", OnOrAfter: &after})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `{"property":"Due","on_or_after":"2021-05-10"}`
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTextObject_dateMention(t *testing.T) {
+	p, err := convProperty(map[string]interface{}{
+		"id":   "nt",
+		"type": "text",
+		"text": []interface{}{map[string]interface{}{
+			"type":       "mention",
+			"plain_text": "2021-05-11 → 2021-05-13",
+			"mention": map[string]interface{}{
+				"type": "date",
+				"date": map[string]interface{}{"start": "2021-05-11", "end": "2021-05-13", "time_zone": nil},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mention := p.(*TextProperty).Text[0].Mention
+	if mention == nil || mention.Type != DateMentionObject || mention.Date == nil {
+		t.Fatalf("unexpected mention: %+v", mention)
+	}
+
+	if diff := cmp.Diff(mention.Date.String(), "2021-05-11/2021-05-13"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,199 @@
+package notion
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// DiffPages returns the update request which changes the properties of from into the ones of to.
+// Only the properties whose values differ are included, comparing rich text by content and
+// annotations and multi-select options, people and relations as sets.
+// Properties computed by Notion and properties missing from to are ignored.
+// It returns nil when nothing changed.
+func DiffPages(from, to *Page) *UpdatePageRequest {
+	return diffProperties(from.Properties, to.Properties)
+}
+
+// DiffStruct returns the update request which changes the page properties into the fields of v,
+// which is mapped with MarshalProperties. It returns nil when nothing changed.
+func DiffStruct(page *Page, v interface{}) (*UpdatePageRequest, error) {
+	properties, err := MarshalProperties(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffProperties(page.Properties, properties), nil
+}
+
+func diffProperties(from, to map[string]PropertyValue) *UpdatePageRequest {
+	changed := map[string]PropertyValue{}
+	for name, p := range to {
+		if readOnlyPropertyTypes[p.GetType()] {
+			continue
+		}
+
+		if old, ok := from[name]; ok && PropertyEqual(old, p) {
+			continue
+		}
+		changed[name] = p
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return &UpdatePageRequest{Properties: changed}
+}
+
+// PropertyEqual reports whether the properties hold the same value.
+// Metadata such as property IDs and the plain text of rich text are not compared.
+func PropertyEqual(a, b PropertyValue) bool {
+	if a.GetType() != b.GetType() {
+		return false
+	}
+
+	return reflect.DeepEqual(comparableValue(a), comparableValue(b))
+}
+
+type richTextValue struct {
+	Type        RichTextType
+	Content     string
+	Href        string
+	Annotations Annotations
+}
+
+// comparableValue returns the value of the property in a form where equal values are deeply equal.
+func comparableValue(p PropertyValue) interface{} {
+	switch p := p.(type) {
+	case *PageTitleProperty:
+		return richTextValues(p.Title)
+	case *TextProperty:
+		return richTextValues(p.Text)
+	case *SelectProperty:
+		if p.Select == nil {
+			return ""
+		}
+		return p.Select.Name
+	case *StatusProperty:
+		if p.Status == nil {
+			return ""
+		}
+		return p.Status.Name
+	case *MultiSelectProperty:
+		names := []string{}
+		for _, o := range p.MultiSelect {
+			names = append(names, o.Name)
+		}
+		return sortedSet(names)
+	case *DateProperty:
+		if p.Date == nil {
+			return [2]string{}
+		}
+		return [2]string{normalizeDate(&p.Date.Start), normalizeDate(p.Date.End)}
+	case *PersonProperty:
+		ids := []string{}
+		for _, u := range p.People {
+			ids = append(ids, u.ID)
+		}
+		return sortedSet(ids)
+	case *RelationProperty:
+		ids := []string{}
+		for _, r := range p.Relation {
+			ids = append(ids, r.ID)
+		}
+		return sortedSet(ids)
+	case *FilesProperty:
+		return fileKeys(p.Files)
+	case *URLProperty:
+		return p.URL
+	case *EmailProperty:
+		return p.Email
+	case *PhoneNumberProperty:
+		return p.PhoneNumber
+	}
+
+	v, err := propertyValue(p)
+	if err != nil {
+		return p
+	}
+
+	return v
+}
+
+// fileKeys identifies the files of a property. Files hosted by Notion are
+// identified by name, as their signed URLs change on every read.
+func fileKeys(files []File) []string {
+	keys := []string{}
+	for _, f := range files {
+		if f.File != nil {
+			keys = append(keys, string(NotionFileType)+":"+f.Name)
+			continue
+		}
+		keys = append(keys, string(ExternalFileType)+":"+f.URL())
+	}
+
+	return keys
+}
+
+// richTextValues returns the content of the rich text,
+// merging adjacent runs which only differ in how the text is split.
+func richTextValues(text []TextObject) []richTextValue {
+	values := []richTextValue{}
+	for _, t := range text {
+		v := richTextValue{
+			Type:    t.Type,
+			Content: t.PlainText,
+			Href:    t.Href,
+		}
+		if v.Type == "" {
+			v.Type = TextRichTextType
+		}
+		if t.Text != nil {
+			v.Content = t.Text.Content
+		}
+		if t.Annotations != nil {
+			v.Annotations = *t.Annotations
+		}
+		if v.Annotations.Color == "" {
+			v.Annotations.Color = DefaultColor
+		}
+
+		if n := len(values); n > 0 {
+			last := &values[n-1]
+			if last.Type == v.Type && last.Href == v.Href && last.Annotations == v.Annotations {
+				last.Content += v.Content
+				continue
+			}
+		}
+		values = append(values, v)
+	}
+
+	return values
+}
+
+func sortedSet(s []string) []string {
+	seen := map[string]bool{}
+	set := []string{}
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			set = append(set, v)
+		}
+	}
+	sort.Strings(set)
+
+	return set
+}
+
+// normalizeDate formats equal dates and date times identically.
+func normalizeDate(d *DateTime) string {
+	if d == nil || d.IsZero() {
+		return ""
+	}
+	if !d.HasTime {
+		return d.String()
+	}
+
+	return d.Time.UTC().Format(time.RFC3339Nano)
+}
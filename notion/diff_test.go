@@ -0,0 +1,97 @@
+package notion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestDiffPages(t *testing.T) {
+	from := decodePage(t, getTaskPageJSON())
+	to := decodePage(t, getTaskPageJSON())
+
+	if got := DiffPages(from, to); got != nil {
+		t.Fatalf("expected no changes, got %v", got.Properties)
+	}
+
+	// Uploaded files are compared by name, as their signed URLs expire.
+	from.Properties["Attachments"] = &FilesProperty{Type: object.FilesPropertyType, Files: []File{
+		{Name: "spec.pdf", Type: NotionFileType, File: &NotionFile{URL: "https://s3.us-west-2.amazonaws.com/spec.pdf?X-Amz-Signature=a"}},
+	}}
+	to.Properties["Attachments"] = &FilesProperty{Type: object.FilesPropertyType, Files: []File{
+		{Name: "spec.pdf", Type: NotionFileType, File: &NotionFile{URL: "https://s3.us-west-2.amazonaws.com/spec.pdf?X-Amz-Signature=b"}},
+	}}
+	if got := DiffPages(from, to); got != nil {
+		t.Fatalf("expected no changes, got %v", got.Properties)
+	}
+
+	// Reordered options and relations are the same value.
+	tags := to.Properties["Tags"].(*MultiSelectProperty)
+	tags.MultiSelect[0], tags.MultiSelect[1] = tags.MultiSelect[1], tags.MultiSelect[0]
+	to.Properties["Points"] = NewNumber(5)
+	// An empty number differs from 0.
+	to.Properties["Budget"] = NewNumber(0)
+	to.Properties["Status"] = &SelectProperty{Type: object.SelectPropertyType}
+
+	got := DiffPages(from, to)
+	want := &UpdatePageRequest{
+		Properties: map[string]PropertyValue{
+			"Points": NewNumber(5),
+			"Budget": NewNumber(0),
+			"Status": &SelectProperty{Type: object.SelectPropertyType},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDiffStruct(t *testing.T) {
+	page := decodePage(t, getTaskPageJSON())
+	// The struct only holds the start of the date.
+	page.Properties["Due"].(*DateProperty).Date.End = nil
+
+	notes, status := "uuu", "In progress"
+	v := task{
+		Name:      "Write docs",
+		Notes:     &notes,
+		Points:    3,
+		Done:      true,
+		Status:    &status,
+		Tags:      []string{"api", "docs"},
+		Due:       time.Date(2021, 5, 12, 0, 0, 0, 0, time.UTC),
+		Owners:    []UserID{"d40e767c-d7af-4b18-a86d-55c61f1e39a4"},
+		BlockedBy: []PageID{"3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"},
+		Link:      "https://example.org",
+	}
+
+	got, err := DiffStruct(page, v)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected no changes, got %v", got.Properties)
+	}
+
+	v.Name = "Write more docs"
+	v.BlockedBy = nil
+	got, err = DiffStruct(page, v)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := &UpdatePageRequest{
+		Properties: map[string]PropertyValue{
+			"Name": &PageTitleProperty{
+				Type:  object.TitlePropertyType,
+				Title: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Write more docs"}}},
+			},
+			"Blocked by": &RelationProperty{Type: object.RelationPropertyType, Relation: []Relation{}},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,273 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// readOnlyPropertyTypes are the property types computed by Notion, which can not be written.
+var readOnlyPropertyTypes = map[object.PropertyType]bool{
+	object.FormulaPropertyType:        true,
+	object.RollupPropertyType:         true,
+	object.CreatedTimePropertyType:    true,
+	object.CreatedByPropertyType:      true,
+	object.LastEditedTimePropertyType: true,
+	object.LastEditedByPropertyType:   true,
+	object.UniqueIDPropertyType:       true,
+	object.VerificationPropertyType:   true,
+	object.ButtonPropertyType:         true,
+}
+
+// maxAppendChildren is the number of blocks Notion accepts in one append request.
+const maxAppendChildren = 100
+
+// BlockIssue describes a block which could not be copied as it was.
+type BlockIssue struct {
+	BlockID string
+	Type    object.BlockType
+	Reason  string
+}
+
+// DuplicateResult represents the outcome of PagesService.Duplicate.
+type DuplicateResult struct {
+	// IDs maps the original page and block IDs to the IDs of their copies.
+	IDs map[string]string
+	// BlockIssues lists the blocks which were skipped or copied elsewhere.
+	BlockIssues []BlockIssue
+	// Skipped lists the properties which could not be copied, as in MoveResult.Skipped.
+	Skipped []MoveIssue
+}
+
+// blockCopy holds the state shared by the copy of a page tree.
+type blockCopy struct {
+	ids     map[string]string
+	issues  []BlockIssue
+	skipped []MoveIssue
+	// copies holds the IDs of the created pages and blocks, which are not copied again
+	// when the copy is made inside of the tree being copied.
+	copies map[string]bool
+}
+
+func newBlockCopy() *blockCopy {
+	return &blockCopy{ids: map[string]string{}, copies: map[string]bool{}}
+}
+
+func (c *blockCopy) add(oldID, newID string) {
+	c.ids[oldID] = newID
+	c.copies[newID] = true
+}
+
+// Duplicate copies the page, its block tree and its child pages under newParent.
+// DuplicateResult.IDs maps the original page and block IDs to the IDs of their copies,
+// so that references to the original objects can be fixed up by the caller.
+// On error, the result holds the objects copied so far.
+//
+// Pages outside of a database only keep their title.
+// Blocks of types this package does not know, including the ones Notion reports
+// as unsupported, can not be written back; they are skipped and reported in
+// DuplicateResult.BlockIssues. Child pages nested in other blocks are created
+// at the end of the enclosing page, as pages can only be created under a page.
+// Properties of unknown types and files hosted by Notion, whose URLs expire,
+// are not copied either; they are reported in DuplicateResult.Skipped.
+//
+// newParent may be inside of the page itself: the copy only holds the tree as it was
+// before, without the pages and blocks created by the copy.
+func (s *PagesService) Duplicate(ctx context.Context, pageID PageID, newParent Parent) (*DuplicateResult, error) {
+	c := newBlockCopy()
+	_, err := s.duplicate(ctx, pageID, newParent, c)
+	return &DuplicateResult{IDs: c.ids, BlockIssues: c.issues, Skipped: c.skipped}, err
+}
+
+func (s *PagesService) duplicate(ctx context.Context, pageID PageID, newParent Parent, c *blockCopy) (*Page, error) {
+	src, err := s.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, skipped := writableProperties(src.Properties, newParent)
+	c.skipped = append(c.skipped, skipped...)
+	return s.copyPage(ctx, src, newParent, properties, c)
+}
+
+// copyPage creates a page with the properties under newParent and copies the blocks of src into it.
+func (s *PagesService) copyPage(ctx context.Context, src *Page, newParent Parent, properties map[string]PropertyValue, c *blockCopy) (*Page, error) {
+	dst, err := s.Create(ctx, &CreatePageRequest{
+		Parent:     newParent,
+		Properties: properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.add(src.ID, dst.ID)
+
+	nested, err := s.copyChildren(ctx, BlockID(src.ID), BlockID(dst.ID), true, c)
+	if err != nil {
+		return dst, err
+	}
+
+	for _, page := range nested {
+		c.issues = append(c.issues, BlockIssue{BlockID: page.ID, Type: page.Type, Reason: "child page nested in a block is created at the end of the enclosing page"})
+		if _, err := s.duplicate(ctx, PageID(page.ID), &PageParent{PageID: dst.ID}, c); err != nil {
+			return dst, err
+		}
+	}
+
+	return dst, nil
+}
+
+// copyChildren recreates the children of the block from under the block to, in their original order.
+// Child pages are created in place when to is a page; otherwise they are returned,
+// along with the ones nested deeper, for the enclosing page to create.
+func (s *PagesService) copyChildren(ctx context.Context, from, to BlockID, toPage bool, c *blockCopy) ([]*ChildPageBlock, error) {
+	children, err := s.client.Blocks.ListChildren(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	var nested []*ChildPageBlock
+	pending := []Block{}
+	flush := func() error {
+		for len(pending) > 0 {
+			n := len(pending)
+			if n > maxAppendChildren {
+				n = maxAppendChildren
+			}
+			chunk := pending[:n]
+			pending = pending[n:]
+
+			inner, err := s.appendCopies(ctx, to, chunk, c)
+			nested = append(nested, inner...)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, b := range children.Results {
+		if id, _ := blockID(b); c.copies[id] {
+			continue
+		}
+
+		switch b := b.(type) {
+		case *ChildPageBlock:
+			if !toPage {
+				nested = append(nested, b)
+				continue
+			}
+
+			if err := flush(); err != nil {
+				return nested, err
+			}
+			if _, err := s.duplicate(ctx, PageID(b.ID), &PageParent{PageID: string(to)}, c); err != nil {
+				return nested, err
+			}
+		case *UnknownBlock:
+			c.issues = append(c.issues, BlockIssue{BlockID: b.ID, Type: b.Type, Reason: "block type can not be written"})
+		default:
+			pending = append(pending, b)
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nested, err
+	}
+
+	return nested, nil
+}
+
+// appendCopies appends the blocks under the block to and copies the children of each appended block.
+func (s *PagesService) appendCopies(ctx context.Context, to BlockID, blocks []Block, c *blockCopy) ([]*ChildPageBlock, error) {
+	created, err := s.client.Blocks.appendChildren(ctx, to, blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	// The created blocks are returned in the order they were appended, so they are matched by position.
+	var nested []*ChildPageBlock
+	for i, b := range blocks {
+		if i >= len(created) {
+			break
+		}
+
+		oldID, hasChildren := blockID(b)
+		newID, _ := blockID(created[i])
+		c.add(oldID, newID)
+		if !hasChildren {
+			continue
+		}
+
+		inner, err := s.copyChildren(ctx, BlockID(oldID), BlockID(newID), false, c)
+		nested = append(nested, inner...)
+		if err != nil {
+			return nested, err
+		}
+	}
+
+	return nested, nil
+}
+
+// writableProperties returns the properties which can be written to a page under parent,
+// along with the ones which could not be copied.
+// Pages outside of a database can only have a title, and the computed properties
+// are filled in by Notion, so these are left out without being reported.
+func writableProperties(properties map[string]PropertyValue, parent Parent) (map[string]PropertyValue, []MoveIssue) {
+	writable := map[string]PropertyValue{}
+	var skipped []MoveIssue
+	for name, p := range properties {
+		if readOnlyPropertyTypes[p.GetType()] {
+			continue
+		}
+
+		if _, ok := parent.(*DatabaseParent); !ok {
+			if p.GetType() == object.TitlePropertyType {
+				writable[string(object.TitlePropertyType)] = p
+			}
+			continue
+		}
+
+		p, issue := writableValue(p)
+		if issue != "" {
+			skipped = append(skipped, MoveIssue{Property: name, Reason: issue})
+		}
+		if p != nil {
+			writable[name] = p
+		}
+	}
+	sort.Slice(skipped, func(i, j int) bool {
+		return skipped[i].Property < skipped[j].Property
+	})
+
+	return writable, skipped
+}
+
+// writableValue returns the part of the property value which can be written back,
+// or nil if there is none, and the reason why the rest was left out.
+// Files hosted by Notion can not be written, as their URLs expire; external files are kept.
+func writableValue(p PropertyValue) (PropertyValue, string) {
+	switch p := p.(type) {
+	case *UnknownProperty:
+		return nil, fmt.Sprintf("%s property can not be written", p.Type)
+	case *FilesProperty:
+		external := []File{}
+		for _, f := range p.Files {
+			if f.External != nil {
+				external = append(external, f)
+			}
+		}
+		if len(external) == len(p.Files) {
+			return p, ""
+		}
+
+		issue := "files hosted by Notion can not be copied"
+		if len(external) == 0 {
+			return nil, issue
+		}
+		return &FilesProperty{Type: p.Type, ID: p.ID, Files: external}, issue
+	}
+
+	return p, ""
+}
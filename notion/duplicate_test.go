@@ -0,0 +1,401 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeWorkspace serves the pages and blocks endpoints from memory.
+type fakeWorkspace struct {
+	mu       sync.Mutex
+	t        *testing.T
+	seq      int
+	pages    map[string]map[string]interface{}
+	children map[string][]map[string]interface{}
+	created  []map[string]interface{}
+	updated  map[string]map[string]interface{}
+	// listed counts the requests listing the children of each block.
+	listed map[string]int
+}
+
+func newFakeWorkspace(t *testing.T, mux *http.ServeMux) *fakeWorkspace {
+	w := &fakeWorkspace{
+		t:        t,
+		pages:    map[string]map[string]interface{}{},
+		children: map[string][]map[string]interface{}{},
+		updated:  map[string]map[string]interface{}{},
+		listed:   map[string]int{},
+	}
+	mux.HandleFunc("/"+pagesPath, w.createPage)
+	mux.HandleFunc("/"+pagesPath+"/", w.page)
+	mux.HandleFunc("/"+blocksPath+"/", w.blockChildren)
+	return w
+}
+
+func (w *fakeWorkspace) newID() string {
+	w.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", w.seq)
+}
+
+func (w *fakeWorkspace) addPage(id string, parent map[string]interface{}, properties string) {
+	props := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(properties), &props); err != nil {
+		w.t.Fatalf("invalid properties: %v", err)
+	}
+
+	w.pages[id] = map[string]interface{}{
+		"object":     "page",
+		"id":         id,
+		"parent":     parent,
+		"properties": props,
+	}
+}
+
+func (w *fakeWorkspace) addBlock(parentID, id, blockType, content string, hasChildren bool) {
+	c := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(content), &c); err != nil {
+		w.t.Fatalf("invalid content: %v", err)
+	}
+
+	w.children[parentID] = append(w.children[parentID], map[string]interface{}{
+		"object":       "block",
+		"id":           id,
+		"type":         blockType,
+		"has_children": hasChildren,
+		blockType:      c,
+	})
+}
+
+func (w *fakeWorkspace) createPage(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	req := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.t.Fatalf("invalid request: %v", err)
+	}
+	w.created = append(w.created, req)
+
+	parent := req["parent"].(map[string]interface{})
+	for _, key := range []string{"page_id", "database_id", "workspace"} {
+		if _, ok := parent[key]; ok {
+			parent["type"] = key
+		}
+	}
+
+	id := w.newID()
+	w.pages[id] = map[string]interface{}{
+		"object":     "page",
+		"id":         id,
+		"parent":     parent,
+		"properties": req["properties"],
+	}
+	if parentID, ok := parent["page_id"].(string); ok {
+		w.children[parentID] = append(w.children[parentID], map[string]interface{}{
+			"object":       "block",
+			"id":           id,
+			"type":         "child_page",
+			"has_children": false,
+			"child_page":   map[string]interface{}{"title": ""},
+		})
+	}
+	_ = json.NewEncoder(rw).Encode(w.pages[id])
+}
+
+func (w *fakeWorkspace) page(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/"+pagesPath+"/")
+	p, ok := w.pages[id]
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(rw, `{"object": "error", "status": 404, "code": "object_not_found", "message": "not found"}`)
+		return
+	}
+
+	if r.Method == http.MethodPatch {
+		req := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.t.Fatalf("invalid request: %v", err)
+		}
+		w.updated[id] = req
+
+		if archived, ok := req["archived"]; ok {
+			p["archived"] = archived
+		}
+		if props, ok := req["properties"].(map[string]interface{}); ok {
+			for k, v := range props {
+				p["properties"].(map[string]interface{})[k] = v
+			}
+		}
+	}
+
+	_ = json.NewEncoder(rw).Encode(p)
+}
+
+func (w *fakeWorkspace) blockChildren(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"+blocksPath+"/"), "/children")
+	if r.Method == http.MethodPatch {
+		req := struct {
+			Children []map[string]interface{} `json:"children"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.t.Fatalf("invalid request: %v", err)
+		}
+
+		if len(req.Children) > 100 {
+			rw.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(rw, `{"object": "error", "status": 400, "code": "validation_error", "message": "body.children.length should be ≤ 100"}`)
+			return
+		}
+		for _, child := range req.Children {
+			if _, ok := child["id"]; ok || child["type"] == "unsupported" || child["type"] == "child_page" {
+				rw.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(rw, `{"object": "error", "status": 400, "code": "validation_error", "message": "invalid block"}`)
+				return
+			}
+		}
+
+		for _, child := range req.Children {
+			child["object"] = "block"
+			child["id"] = w.newID()
+			child["has_children"] = false
+			w.children[id] = append(w.children[id], child)
+		}
+
+		_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+			"object":      "list",
+			"results":     req.Children,
+			"next_cursor": nil,
+			"has_more":    false,
+		})
+		return
+	}
+
+	w.listed[id]++
+	children := w.children[id]
+	if children == nil {
+		children = []map[string]interface{}{}
+	}
+	_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+		"object":      "list",
+		"results":     children,
+		"next_cursor": nil,
+		"has_more":    false,
+	})
+}
+
+func TestPagesService_Duplicate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newFakeWorkspace(t, mux)
+	src := "b55c9c91-384d-452b-81db-d1ef79372b75"
+	child := "7636e2c9-b6c1-4df1-aeae-3ebf0073c5cb"
+	nested := "2a1e4f0c-3f4e-4c65-9a61-0d2f5b5c8e11"
+	toggle := "7face6fd-3ef4-4b38-b1dc-c5044988eec0"
+	w.addPage(src, map[string]interface{}{"type": "workspace", "workspace": true}, `{
+		"title": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Notes"}, "plain_text": "Notes"}]}
+	}`)
+	w.addPage(child, map[string]interface{}{"type": "page_id", "page_id": src}, `{
+		"title": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Child"}, "plain_text": "Child"}]}
+	}`)
+	w.addPage(nested, map[string]interface{}{"type": "page_id", "page_id": src}, `{
+		"title": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Nested"}, "plain_text": "Nested"}]}
+	}`)
+	w.addBlock(src, "9bc30ad4-9373-46a5-84ab-0a7845ee52e6", "heading_2", `{"text": [{"type": "text", "text": {"content": "Agenda"}, "plain_text": "Agenda"}]}`, false)
+	w.addBlock(src, child, "child_page", `{"title": "Child"}`, false)
+	w.addBlock(src, toggle, "toggle", `{"text": [{"type": "text", "text": {"content": "Details"}, "plain_text": "Details"}]}`, true)
+	w.addBlock(toggle, "4f555b50-3a9b-49cb-924c-3746f4ca5522", "to_do", `{"text": [], "checked": true}`, false)
+	w.addBlock(toggle, nested, "child_page", `{"title": "Nested"}`, false)
+	w.addBlock(src, "d2a3c3e8-0b61-4a4b-8d7c-4b0e7f9b2c55", "unsupported", `{}`, false)
+
+	parent := &PageParent{PageID: "668d797c-76fa-4934-9b05-ad288df2d136"}
+	got, err := client.Pages.Duplicate(context.Background(), PageID(src), parent)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := &DuplicateResult{
+		IDs: map[string]string{
+			src:                                    "00000000-0000-4000-8000-000000000001",
+			"9bc30ad4-9373-46a5-84ab-0a7845ee52e6": "00000000-0000-4000-8000-000000000002",
+			child:                                  "00000000-0000-4000-8000-000000000003",
+			toggle:                                 "00000000-0000-4000-8000-000000000004",
+			"4f555b50-3a9b-49cb-924c-3746f4ca5522": "00000000-0000-4000-8000-000000000005",
+			nested:                                 "00000000-0000-4000-8000-000000000006",
+		},
+		BlockIssues: []BlockIssue{
+			{BlockID: "d2a3c3e8-0b61-4a4b-8d7c-4b0e7f9b2c55", Type: "unsupported", Reason: "block type can not be written"},
+			{BlockID: nested, Type: "child_page", Reason: "child page nested in a block is created at the end of the enclosing page"},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if n := len(w.created); n != 3 {
+		t.Fatalf("expected 3 pages to be created, got %d", n)
+	}
+
+	for _, c := range w.created[1:] {
+		if got := c["parent"].(map[string]interface{})["page_id"]; got != want.IDs[src] {
+			t.Fatalf("child page not created under the copy: %v", got)
+		}
+	}
+
+	order := []string{}
+	for _, b := range w.children[want.IDs[src]] {
+		order = append(order, b["id"].(string))
+	}
+	wantOrder := []string{want.IDs["9bc30ad4-9373-46a5-84ab-0a7845ee52e6"], want.IDs[child], want.IDs[toggle], want.IDs[nested]}
+	if diff := cmp.Diff(order, wantOrder); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	copied := w.children[want.IDs[toggle]]
+	if len(copied) != 1 || copied[0]["type"] != "to_do" || copied[0]["to_do"].(map[string]interface{})["checked"] != true {
+		t.Fatalf("nested blocks not copied: %v", copied)
+	}
+}
+
+func TestPagesService_Duplicate_manyBlocks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newFakeWorkspace(t, mux)
+	src := "b55c9c91-384d-452b-81db-d1ef79372b75"
+	child := "7636e2c9-b6c1-4df1-aeae-3ebf0073c5cb"
+	w.addPage(src, map[string]interface{}{"type": "workspace", "workspace": true}, `{}`)
+	w.addPage(child, map[string]interface{}{"type": "page_id", "page_id": src}, `{}`)
+	for i := 0; i < 250; i++ {
+		if i == 120 {
+			w.addBlock(src, child, "child_page", `{"title": ""}`, false)
+		}
+		w.addBlock(src, fmt.Sprintf("10000000-0000-4000-8000-%012d", i), "paragraph", fmt.Sprintf(`{"text": [{"type": "text", "text": {"content": "%d"}, "plain_text": "%d"}]}`, i, i), false)
+	}
+
+	got, err := client.Pages.Duplicate(context.Background(), PageID(src), &PageParent{PageID: "668d797c-76fa-4934-9b05-ad288df2d136"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(w.listed, map[string]int{src: 1, child: 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	copied := w.children[got.IDs[src]]
+	if n := len(copied); n != 251 {
+		t.Fatalf("expected 251 blocks to be copied, got %d", n)
+	}
+
+	for i, b := range copied {
+		if i == 120 {
+			if b["id"] != got.IDs[child] {
+				t.Fatalf("child page not copied in place: %v", b)
+			}
+			continue
+		}
+
+		n := i
+		if i > 120 {
+			n--
+		}
+		oldID := fmt.Sprintf("10000000-0000-4000-8000-%012d", n)
+		if b["id"] != got.IDs[oldID] {
+			t.Fatalf("block %s mapped to %s, copied as %v", oldID, got.IDs[oldID], b["id"])
+		}
+		text := b["paragraph"].(map[string]interface{})["text"].([]interface{})[0].(map[string]interface{})["plain_text"]
+		if text != fmt.Sprint(n) {
+			t.Fatalf("block %d copied out of order: %v", n, text)
+		}
+	}
+}
+
+func TestPagesService_Duplicate_intoItself(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newFakeWorkspace(t, mux)
+	src := "b55c9c91-384d-452b-81db-d1ef79372b75"
+	child := "7636e2c9-b6c1-4df1-aeae-3ebf0073c5cb"
+	w.addPage(src, map[string]interface{}{"type": "workspace", "workspace": true}, `{}`)
+	w.addPage(child, map[string]interface{}{"type": "page_id", "page_id": src}, `{}`)
+	w.addBlock(src, child, "child_page", `{"title": ""}`, false)
+	w.addBlock(child, "9bc30ad4-9373-46a5-84ab-0a7845ee52e6", "paragraph", `{"text": []}`, false)
+
+	got, err := client.Pages.Duplicate(context.Background(), PageID(src), &PageParent{PageID: child})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := map[string]string{
+		src:                                    "00000000-0000-4000-8000-000000000001",
+		child:                                  "00000000-0000-4000-8000-000000000002",
+		"9bc30ad4-9373-46a5-84ab-0a7845ee52e6": "00000000-0000-4000-8000-000000000003",
+	}
+	if diff := cmp.Diff(got.IDs, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if n := len(w.created); n != 2 {
+		t.Fatalf("expected 2 pages to be created, got %d", n)
+	}
+}
+
+func TestPagesService_Duplicate_unwritableProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newFakeWorkspace(t, mux)
+	src := "b55c9c91-384d-452b-81db-d1ef79372b75"
+	w.addPage(src, map[string]interface{}{"type": "database_id", "database_id": "668d797c-76fa-4934-9b05-ad288df2d136"}, `{
+		"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Notes"}, "plain_text": "Notes"}]},
+		"Attachments": {"id": "att", "type": "files", "files": [
+			{"name": "scan.pdf", "type": "file", "file": {"url": "https://s3.us-west-2.amazonaws.com/scan.pdf", "expiry_time": "2021-05-13T10:00:00.000Z"}},
+			{"name": "spec", "type": "external", "external": {"url": "https://example.com/spec.pdf"}}
+		]},
+		"Photos": {"id": "pho", "type": "files", "files": [
+			{"name": "photo.png", "type": "file", "file": {"url": "https://s3.us-west-2.amazonaws.com/photo.png", "expiry_time": "2021-05-13T10:00:00.000Z"}}
+		]},
+		"Place": {"id": "pla", "type": "place", "place": {"lat": 35.68, "lon": 139.76}}
+	}`)
+
+	got, err := client.Pages.Duplicate(context.Background(), PageID(src), &DatabaseParent{DatabaseID: "668d797c-76fa-4934-9b05-ad288df2d136"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []MoveIssue{
+		{Property: "Attachments", Reason: "files hosted by Notion can not be copied"},
+		{Property: "Photos", Reason: "files hosted by Notion can not be copied"},
+		{Property: "Place", Reason: "place property can not be written"},
+	}
+	if diff := cmp.Diff(got.Skipped, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	properties := w.created[0]["properties"].(map[string]interface{})
+	if _, ok := properties["Photos"]; ok {
+		t.Fatalf("hosted files written: %v", properties["Photos"])
+	}
+	if _, ok := properties["Place"]; ok {
+		t.Fatalf("unknown property written: %v", properties["Place"])
+	}
+
+	files := properties["Attachments"].(map[string]interface{})["files"].([]interface{})
+	if len(files) != 1 || files[0].(map[string]interface{})["type"] != "external" {
+		t.Fatalf("external files not kept: %v", files)
+	}
+}
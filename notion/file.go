@@ -0,0 +1,56 @@
+package notion
+
+import "time"
+
+// FileType is type of the file object.
+type FileType string
+
+const (
+	NotionFileType   FileType = "file"
+	ExternalFileType FileType = "external"
+)
+
+// File object represents Notion file, which is either hosted by Notion or external.
+//
+// API doc: https://developers.notion.com/reference/file-object
+//go:generate gomodifytags --file $GOFILE --struct File -add-tags json,mapstructure -w -transform snakecase
+type File struct {
+	Name     string        `json:"name,omitempty" mapstructure:"name"`
+	Type     FileType      `json:"type" mapstructure:"type"`
+	File     *NotionFile   `json:"file,omitempty" mapstructure:"file"`
+	External *ExternalFile `json:"external,omitempty" mapstructure:"external"`
+}
+
+// NotionFile object represents a file hosted by Notion, whose URL expires.
+type NotionFile struct {
+	URL        string    `json:"url" mapstructure:"url"`
+	ExpiryTime time.Time `json:"expiry_time" mapstructure:"expiry_time"`
+}
+
+// ExternalFile object represents a file hosted outside of Notion.
+type ExternalFile struct {
+	URL string `json:"url" mapstructure:"url"`
+}
+
+// NewExternalFile returns the file object linking to the URL.
+func NewExternalFile(name, url string) File {
+	return File{Name: name, Type: ExternalFileType, External: &ExternalFile{URL: url}}
+}
+
+// URL returns the URL of the file.
+func (f File) URL() string {
+	switch {
+	case f.File != nil:
+		return f.File.URL
+	case f.External != nil:
+		return f.External.URL
+	}
+
+	return ""
+}
+
+// Expired reports whether the URL of a file hosted by Notion has expired at t.
+// External files never expire.
+func (f File) Expired(t time.Time) bool {
+	return f.File != nil && !f.File.ExpiryTime.IsZero() && !t.Before(f.File.ExpiryTime)
+}
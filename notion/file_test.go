@@ -0,0 +1,48 @@
+package notion
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFile(t *testing.T) {
+	expiry := time.Date(2021, 5, 18, 10, 0, 0, 0, time.UTC)
+
+	tcs := map[string]struct {
+		file        File
+		now         time.Time
+		wantURL     string
+		wantExpired bool
+	}{
+		"notion file": {
+			File{Type: NotionFileType, File: &NotionFile{URL: "https://s3.us-west-2.amazonaws.com/kale.png", ExpiryTime: expiry}},
+			expiry.Add(-time.Minute),
+			"https://s3.us-west-2.amazonaws.com/kale.png",
+			false,
+		},
+		"expired notion file": {
+			File{Type: NotionFileType, File: &NotionFile{URL: "https://s3.us-west-2.amazonaws.com/kale.png", ExpiryTime: expiry}},
+			expiry,
+			"https://s3.us-west-2.amazonaws.com/kale.png",
+			true,
+		},
+		"external file": {
+			NewExternalFile("Kale", "https://example.org/kale.png"),
+			expiry,
+			"https://example.org/kale.png",
+			false,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if got := tc.file.URL(); got != tc.wantURL {
+				t.Fatalf("URL: got %q, want %q", got, tc.wantURL)
+			}
+
+			if got := tc.file.Expired(tc.now); got != tc.wantExpired {
+				t.Fatalf("Expired: got %v, want %v", got, tc.wantExpired)
+			}
+		})
+	}
+}
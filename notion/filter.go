@@ -0,0 +1,553 @@
+package notion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// QueryFilter is a database query filter: a condition on a property, or a compound of filters.
+// Notion accepts compounds nested two levels deep, such as an Or inside an And;
+// deeper filters fail to encode. Filters are built with Where, And and Or:
+//
+//	Where("Status").Select().Equals("Done").And(Where("Points").Number().GreaterThan(0))
+//
+// API doc: https://developers.notion.com/reference/post-database-query-filter
+type QueryFilter struct {
+	property string
+	// path is the keys of the condition, such as ["formula", "number"].
+	path     []string
+	operator string
+	value    interface{}
+	compound CompoundFilterType
+	filters  []*QueryFilter
+}
+
+// And returns the filter matching the pages matched by f and all the filters.
+func (f *QueryFilter) And(filters ...*QueryFilter) *QueryFilter {
+	return compound(AndFilter, append([]*QueryFilter{f}, filters...))
+}
+
+// Or returns the filter matching the pages matched by f or any of the filters.
+func (f *QueryFilter) Or(filters ...*QueryFilter) *QueryFilter {
+	return compound(OrFilter, append([]*QueryFilter{f}, filters...))
+}
+
+// And returns the filter matching the pages matched by all the filters.
+func And(filters ...*QueryFilter) *QueryFilter {
+	return compound(AndFilter, filters)
+}
+
+// Or returns the filter matching the pages matched by any of the filters.
+func Or(filters ...*QueryFilter) *QueryFilter {
+	return compound(OrFilter, filters)
+}
+
+// compound returns the compound of the filters. Compounds of the same type are flattened,
+// so that chaining And calls does not nest the filters.
+func compound(t CompoundFilterType, filters []*QueryFilter) *QueryFilter {
+	f := &QueryFilter{compound: t}
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+		if filter.compound == t {
+			f.filters = append(f.filters, filter.filters...)
+			continue
+		}
+		f.filters = append(f.filters, filter)
+	}
+
+	return f
+}
+
+// maxFilterDepth is how deep Notion accepts compound filters to be nested.
+const maxFilterDepth = 2
+
+// MarshalJSON encodes the filter object.
+func (f *QueryFilter) MarshalJSON() ([]byte, error) {
+	if f == nil {
+		return nil, errors.New("nil filter")
+	}
+
+	if f.compound != "" {
+		if depth := f.depth(); depth > maxFilterDepth {
+			return nil, fmt.Errorf("compound filters are nested %d levels deep, at most %d are allowed", depth, maxFilterDepth)
+		}
+
+		filters := f.filters
+		if filters == nil {
+			filters = []*QueryFilter{}
+		}
+		return json.Marshal(map[CompoundFilterType][]*QueryFilter{f.compound: filters})
+	}
+
+	if len(f.path) == 0 {
+		return nil, errors.New("filter has no condition; build it with Where, And or Or")
+	}
+
+	var condition interface{} = map[string]interface{}{f.operator: f.value}
+	for i := len(f.path) - 1; i > 0; i-- {
+		condition = map[string]interface{}{f.path[i]: condition}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"property": f.property,
+		f.path[0]:  condition,
+	})
+}
+
+// depth returns how many levels of compound filters f is made of.
+func (f *QueryFilter) depth() int {
+	if f == nil || f.compound == "" {
+		return 0
+	}
+
+	max := 0
+	for _, filter := range f.filters {
+		if d := filter.depth(); d > max {
+			max = d
+		}
+	}
+
+	return max + 1
+}
+
+// PropertyCondition selects the type of the property a filter applies to.
+type PropertyCondition struct {
+	property string
+	path     []string
+}
+
+// Where starts a filter on the property, which is a name or an ID.
+func Where(property string) *PropertyCondition {
+	return &PropertyCondition{property: property}
+}
+
+func (c *PropertyCondition) with(propertyType object.PropertyType) *PropertyCondition {
+	path := append(append([]string{}, c.path...), string(propertyType))
+	return &PropertyCondition{property: c.property, path: path}
+}
+
+func (c *PropertyCondition) filter(operator string, value interface{}) *QueryFilter {
+	return &QueryFilter{property: c.property, path: c.path, operator: operator, value: value}
+}
+
+// Title filters a title property.
+func (c *PropertyCondition) Title() *TextCondition {
+	return &TextCondition{c.with(object.TitlePropertyType)}
+}
+
+// Text filters a rich text property.
+func (c *PropertyCondition) Text() *TextCondition {
+	return &TextCondition{c.with(object.TextPropertyType)}
+}
+
+// URL filters a URL property.
+func (c *PropertyCondition) URL() *TextCondition {
+	return &TextCondition{c.with(object.URLPropertyType)}
+}
+
+// Email filters an email property.
+func (c *PropertyCondition) Email() *TextCondition {
+	return &TextCondition{c.with(object.EmailPropertyType)}
+}
+
+// PhoneNumber filters a phone number property.
+func (c *PropertyCondition) PhoneNumber() *TextCondition {
+	return &TextCondition{c.with(object.PhoneNumberPropertyType)}
+}
+
+// Number filters a number property.
+func (c *PropertyCondition) Number() *NumberCondition {
+	return &NumberCondition{c.with(object.NumberPropertyType)}
+}
+
+// UniqueID filters a unique ID property by its number.
+func (c *PropertyCondition) UniqueID() *NumberCondition {
+	return &NumberCondition{c.with(object.UniqueIDPropertyType)}
+}
+
+// Checkbox filters a checkbox property.
+func (c *PropertyCondition) Checkbox() *CheckboxCondition {
+	return &CheckboxCondition{c.with(object.CheckboxPropertyType)}
+}
+
+// Select filters a select property.
+func (c *PropertyCondition) Select() *SelectCondition {
+	return &SelectCondition{c.with(object.SelectPropertyType)}
+}
+
+// Status filters a status property.
+func (c *PropertyCondition) Status() *SelectCondition {
+	return &SelectCondition{c.with(object.StatusPropertyType)}
+}
+
+// MultiSelect filters a multi-select property.
+func (c *PropertyCondition) MultiSelect() *ContainsCondition {
+	return &ContainsCondition{c.with(object.MultiSelectPropertyType)}
+}
+
+// People filters a people property by user ID.
+func (c *PropertyCondition) People() *ContainsCondition {
+	return &ContainsCondition{c.with(object.PeoplePropertyType)}
+}
+
+// Relation filters a relation property by page ID.
+func (c *PropertyCondition) Relation() *ContainsCondition {
+	return &ContainsCondition{c.with(object.RelationPropertyType)}
+}
+
+// CreatedBy filters a created by property by user ID.
+func (c *PropertyCondition) CreatedBy() *ContainsCondition {
+	return &ContainsCondition{c.with(object.CreatedByPropertyType)}
+}
+
+// LastEditedBy filters a last edited by property by user ID.
+func (c *PropertyCondition) LastEditedBy() *ContainsCondition {
+	return &ContainsCondition{c.with(object.LastEditedByPropertyType)}
+}
+
+// Date filters a date property.
+func (c *PropertyCondition) Date() *DateCondition {
+	return &DateCondition{c.with(object.DatePropertyType)}
+}
+
+// CreatedTime filters a created time property.
+func (c *PropertyCondition) CreatedTime() *DateCondition {
+	return &DateCondition{c.with(object.CreatedTimePropertyType)}
+}
+
+// LastEditedTime filters a last edited time property.
+func (c *PropertyCondition) LastEditedTime() *DateCondition {
+	return &DateCondition{c.with(object.LastEditedTimePropertyType)}
+}
+
+// Files filters a files property.
+func (c *PropertyCondition) Files() *EmptyCondition {
+	return &EmptyCondition{c.with(object.FilesPropertyType)}
+}
+
+// Verification filters a verification property.
+func (c *PropertyCondition) Verification() *VerificationCondition {
+	return &VerificationCondition{c.with(object.VerificationPropertyType)}
+}
+
+// Formula filters a formula property by the type of its result.
+func (c *PropertyCondition) Formula() *FormulaCondition {
+	return &FormulaCondition{c.with(object.FormulaPropertyType)}
+}
+
+// Rollup filters a rollup property.
+func (c *PropertyCondition) Rollup() *RollupCondition {
+	return &RollupCondition{c.with(object.RollupPropertyType)}
+}
+
+// EmptyCondition filters properties which can only be tested for emptiness.
+type EmptyCondition struct {
+	c *PropertyCondition
+}
+
+// IsEmpty matches the pages whose property is empty.
+func (c *EmptyCondition) IsEmpty() *QueryFilter {
+	return c.c.filter("is_empty", true)
+}
+
+// IsNotEmpty matches the pages whose property is not empty.
+func (c *EmptyCondition) IsNotEmpty() *QueryFilter {
+	return c.c.filter("is_not_empty", true)
+}
+
+// TextCondition filters title, rich text, URL, email and phone number properties.
+type TextCondition struct {
+	c *PropertyCondition
+}
+
+// Equals matches the pages whose property is s.
+func (c *TextCondition) Equals(s string) *QueryFilter {
+	return c.c.filter("equals", s)
+}
+
+// DoesNotEqual matches the pages whose property is not s.
+func (c *TextCondition) DoesNotEqual(s string) *QueryFilter {
+	return c.c.filter("does_not_equal", s)
+}
+
+// Contains matches the pages whose property contains s.
+func (c *TextCondition) Contains(s string) *QueryFilter {
+	return c.c.filter("contains", s)
+}
+
+// DoesNotContain matches the pages whose property does not contain s.
+func (c *TextCondition) DoesNotContain(s string) *QueryFilter {
+	return c.c.filter("does_not_contain", s)
+}
+
+// StartsWith matches the pages whose property starts with s.
+func (c *TextCondition) StartsWith(s string) *QueryFilter {
+	return c.c.filter("starts_with", s)
+}
+
+// EndsWith matches the pages whose property ends with s.
+func (c *TextCondition) EndsWith(s string) *QueryFilter {
+	return c.c.filter("ends_with", s)
+}
+
+// IsEmpty matches the pages whose property is empty.
+func (c *TextCondition) IsEmpty() *QueryFilter {
+	return c.c.filter("is_empty", true)
+}
+
+// IsNotEmpty matches the pages whose property is not empty.
+func (c *TextCondition) IsNotEmpty() *QueryFilter {
+	return c.c.filter("is_not_empty", true)
+}
+
+// NumberCondition filters number and unique ID properties.
+type NumberCondition struct {
+	c *PropertyCondition
+}
+
+// Equals matches the pages whose property is n.
+func (c *NumberCondition) Equals(n float64) *QueryFilter {
+	return c.c.filter("equals", n)
+}
+
+// DoesNotEqual matches the pages whose property is not n.
+func (c *NumberCondition) DoesNotEqual(n float64) *QueryFilter {
+	return c.c.filter("does_not_equal", n)
+}
+
+// GreaterThan matches the pages whose property is greater than n.
+func (c *NumberCondition) GreaterThan(n float64) *QueryFilter {
+	return c.c.filter("greater_than", n)
+}
+
+// LessThan matches the pages whose property is less than n.
+func (c *NumberCondition) LessThan(n float64) *QueryFilter {
+	return c.c.filter("less_than", n)
+}
+
+// GreaterThanOrEqualTo matches the pages whose property is greater than or equal to n.
+func (c *NumberCondition) GreaterThanOrEqualTo(n float64) *QueryFilter {
+	return c.c.filter("greater_than_or_equal_to", n)
+}
+
+// LessThanOrEqualTo matches the pages whose property is less than or equal to n.
+func (c *NumberCondition) LessThanOrEqualTo(n float64) *QueryFilter {
+	return c.c.filter("less_than_or_equal_to", n)
+}
+
+// IsEmpty matches the pages whose property is empty.
+func (c *NumberCondition) IsEmpty() *QueryFilter {
+	return c.c.filter("is_empty", true)
+}
+
+// IsNotEmpty matches the pages whose property is not empty.
+func (c *NumberCondition) IsNotEmpty() *QueryFilter {
+	return c.c.filter("is_not_empty", true)
+}
+
+// CheckboxCondition filters checkbox properties.
+type CheckboxCondition struct {
+	c *PropertyCondition
+}
+
+// Equals matches the pages whose property is b.
+func (c *CheckboxCondition) Equals(b bool) *QueryFilter {
+	return c.c.filter("equals", b)
+}
+
+// DoesNotEqual matches the pages whose property is not b.
+func (c *CheckboxCondition) DoesNotEqual(b bool) *QueryFilter {
+	return c.c.filter("does_not_equal", b)
+}
+
+// SelectCondition filters select and status properties by option name.
+type SelectCondition struct {
+	c *PropertyCondition
+}
+
+// Equals matches the pages whose property is the option.
+func (c *SelectCondition) Equals(option string) *QueryFilter {
+	return c.c.filter("equals", option)
+}
+
+// DoesNotEqual matches the pages whose property is not the option.
+func (c *SelectCondition) DoesNotEqual(option string) *QueryFilter {
+	return c.c.filter("does_not_equal", option)
+}
+
+// IsEmpty matches the pages whose property is empty.
+func (c *SelectCondition) IsEmpty() *QueryFilter {
+	return c.c.filter("is_empty", true)
+}
+
+// IsNotEmpty matches the pages whose property is not empty.
+func (c *SelectCondition) IsNotEmpty() *QueryFilter {
+	return c.c.filter("is_not_empty", true)
+}
+
+// ContainsCondition filters the properties holding several values: multi-select options,
+// and the IDs of people and related pages.
+type ContainsCondition struct {
+	c *PropertyCondition
+}
+
+// Contains matches the pages whose property contains the value.
+func (c *ContainsCondition) Contains(value string) *QueryFilter {
+	return c.c.filter("contains", value)
+}
+
+// DoesNotContain matches the pages whose property does not contain the value.
+func (c *ContainsCondition) DoesNotContain(value string) *QueryFilter {
+	return c.c.filter("does_not_contain", value)
+}
+
+// IsEmpty matches the pages whose property is empty.
+func (c *ContainsCondition) IsEmpty() *QueryFilter {
+	return c.c.filter("is_empty", true)
+}
+
+// IsNotEmpty matches the pages whose property is not empty.
+func (c *ContainsCondition) IsNotEmpty() *QueryFilter {
+	return c.c.filter("is_not_empty", true)
+}
+
+// DateCondition filters date, created time and last edited time properties.
+type DateCondition struct {
+	c *PropertyCondition
+}
+
+// Equals matches the pages whose property is the date.
+func (c *DateCondition) Equals(date DateTime) *QueryFilter {
+	return c.c.filter("equals", date)
+}
+
+// Before matches the pages whose property is before the date.
+func (c *DateCondition) Before(date DateTime) *QueryFilter {
+	return c.c.filter("before", date)
+}
+
+// After matches the pages whose property is after the date.
+func (c *DateCondition) After(date DateTime) *QueryFilter {
+	return c.c.filter("after", date)
+}
+
+// OnOrBefore matches the pages whose property is on or before the date.
+func (c *DateCondition) OnOrBefore(date DateTime) *QueryFilter {
+	return c.c.filter("on_or_before", date)
+}
+
+// OnOrAfter matches the pages whose property is on or after the date.
+func (c *DateCondition) OnOrAfter(date DateTime) *QueryFilter {
+	return c.c.filter("on_or_after", date)
+}
+
+// PastWeek matches the pages whose property is within the past week.
+func (c *DateCondition) PastWeek() *QueryFilter {
+	return c.c.filter("past_week", EmptyConfig{})
+}
+
+// PastMonth matches the pages whose property is within the past month.
+func (c *DateCondition) PastMonth() *QueryFilter {
+	return c.c.filter("past_month", EmptyConfig{})
+}
+
+// PastYear matches the pages whose property is within the past year.
+func (c *DateCondition) PastYear() *QueryFilter {
+	return c.c.filter("past_year", EmptyConfig{})
+}
+
+// NextWeek matches the pages whose property is within the next week.
+func (c *DateCondition) NextWeek() *QueryFilter {
+	return c.c.filter("next_week", EmptyConfig{})
+}
+
+// NextMonth matches the pages whose property is within the next month.
+func (c *DateCondition) NextMonth() *QueryFilter {
+	return c.c.filter("next_month", EmptyConfig{})
+}
+
+// NextYear matches the pages whose property is within the next year.
+func (c *DateCondition) NextYear() *QueryFilter {
+	return c.c.filter("next_year", EmptyConfig{})
+}
+
+// IsEmpty matches the pages whose property is empty.
+func (c *DateCondition) IsEmpty() *QueryFilter {
+	return c.c.filter("is_empty", true)
+}
+
+// IsNotEmpty matches the pages whose property is not empty.
+func (c *DateCondition) IsNotEmpty() *QueryFilter {
+	return c.c.filter("is_not_empty", true)
+}
+
+// VerificationCondition filters verification properties.
+type VerificationCondition struct {
+	c *PropertyCondition
+}
+
+// Is matches the pages whose verification is in the state.
+func (c *VerificationCondition) Is(state VerificationState) *QueryFilter {
+	return c.c.filter("status", state)
+}
+
+// FormulaCondition selects the type of the result of a formula property.
+type FormulaCondition struct {
+	c *PropertyCondition
+}
+
+// Text filters a formula returning text.
+func (c *FormulaCondition) Text() *TextCondition {
+	return &TextCondition{c.c.with(object.TextPropertyType)}
+}
+
+// Number filters a formula returning a number.
+func (c *FormulaCondition) Number() *NumberCondition {
+	return &NumberCondition{c.c.with(object.NumberPropertyType)}
+}
+
+// Checkbox filters a formula returning a checkbox.
+func (c *FormulaCondition) Checkbox() *CheckboxCondition {
+	return &CheckboxCondition{c.c.with(object.CheckboxPropertyType)}
+}
+
+// Date filters a formula returning a date.
+func (c *FormulaCondition) Date() *DateCondition {
+	return &DateCondition{c.c.with(object.DatePropertyType)}
+}
+
+// RollupCondition selects how a rollup property is filtered.
+type RollupCondition struct {
+	c *PropertyCondition
+}
+
+// Any filters a rollup returning an array, matching the pages where any of the values matches.
+// The type of the values is selected on the returned condition.
+func (c *RollupCondition) Any() *PropertyCondition {
+	return c.c.with("any")
+}
+
+// Every filters a rollup returning an array, matching the pages where every value matches.
+func (c *RollupCondition) Every() *PropertyCondition {
+	return c.c.with("every")
+}
+
+// None filters a rollup returning an array, matching the pages where no value matches.
+func (c *RollupCondition) None() *PropertyCondition {
+	return c.c.with("none")
+}
+
+// Number filters a rollup returning a number.
+func (c *RollupCondition) Number() *NumberCondition {
+	return &NumberCondition{c.c.with(object.NumberPropertyType)}
+}
+
+// Date filters a rollup returning a date.
+func (c *RollupCondition) Date() *DateCondition {
+	return &DateCondition{c.c.with(object.DatePropertyType)}
+}
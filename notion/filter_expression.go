@@ -0,0 +1,881 @@
+package notion
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// ExpressionError reports an invalid query expression and where it is invalid.
+type ExpressionError struct {
+	Expression string
+	// Offset is the byte offset of the invalid part of the expression.
+	Offset  int
+	Message string
+}
+
+// Column returns the column of the invalid part of the expression, counted in characters from 1.
+func (e *ExpressionError) Column() int {
+	return utf8.RuneCountInString(e.Expression[:e.Offset]) + 1
+}
+
+// Error implements the error interface
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column(), e.Message)
+}
+
+// Excerpt returns the expression with a caret under the invalid part, to be printed in a monospace font.
+func (e *ExpressionError) Excerpt() string {
+	return e.Expression + "\n" + strings.Repeat(" ", e.Column()-1) + "^"
+}
+
+// ParseQuery compiles a query expression into a query of the database. The expression is
+// a filter followed by an optional sort clause, and either part may be omitted:
+//
+//	Status = "Done" AND (Points >= 3 OR Owner is empty) ORDER BY Points DESC, Name
+//
+// Conditions compare a property, written as is or between backquotes when it contains
+// keywords or symbols, to a string, a number, true or false. Which operators and values are
+// allowed depends on the type of the property in the database:
+//
+//	=, !=                                  text, number, checkbox, select, status and date properties
+//	<, <=, >, >=                           number and date properties
+//	contains, does not contain             text, multi-select, people and relation properties
+//	starts with, ends with                 text properties
+//	is empty, is not empty                 all properties but checkboxes
+//	is past week, is next month, ...       date properties
+//
+// Dates are written as strings such as "2021-05-10", and keywords are case insensitive.
+// Formula and rollup properties are filtered by the type of the value they are compared to.
+// Errors are *ExpressionError.
+func ParseQuery(db *Database, expr string) (*DatabaseQuery, error) {
+	p, err := newExpressionParser(db, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &DatabaseQuery{}
+	if !p.at(eofToken) && !p.atKeyword("order") {
+		f, err := p.filter()
+		if err != nil {
+			return nil, err
+		}
+		q.Filter = f
+	}
+
+	if p.atKeyword("order") {
+		p.next()
+		if err := p.expectKeyword("by"); err != nil {
+			return nil, err
+		}
+
+		sorts, err := p.sorts()
+		if err != nil {
+			return nil, err
+		}
+		q.Sorts = sorts
+	}
+
+	if !p.at(eofToken) {
+		return nil, p.errorf(p.peek().pos, "unexpected %s", p.peek())
+	}
+
+	return q, nil
+}
+
+// ParseFilter compiles a filter expression of the database, as described in ParseQuery.
+func ParseFilter(db *Database, expr string) (*QueryFilter, error) {
+	p, err := newExpressionParser(db, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := p.filter()
+	if err != nil {
+		return nil, err
+	}
+	if !p.at(eofToken) {
+		return nil, p.errorf(p.peek().pos, "unexpected %s", p.peek())
+	}
+
+	return f, nil
+}
+
+// ParseSorts compiles a list of sorts of the database such as `Points DESC, Name`.
+// The created_time and last_edited_time timestamps can be sorted by as well.
+func ParseSorts(db *Database, expr string) ([]Sort, error) {
+	p, err := newExpressionParser(db, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.sorts()
+}
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	wordToken
+	nameToken
+	stringToken
+	numberToken
+	operatorToken
+	leftParenToken
+	rightParenToken
+	commaToken
+)
+
+type token struct {
+	kind tokenKind
+	// text is the token as written, and value the unquoted string or name.
+	text  string
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == eofToken {
+		return "end of expression"
+	}
+
+	return fmt.Sprintf("%q", t.text)
+}
+
+// operatorKeywords end the property names which are not quoted.
+var operatorKeywords = map[string]bool{
+	"is": true, "contains": true, "does": true, "starts": true, "ends": true,
+	"and": true, "or": true, "order": true,
+}
+
+type expressionParser struct {
+	db     *Database
+	expr   string
+	tokens []token
+	i      int
+	// groups holds the position of the opening parenthesis of the compounds written between parentheses.
+	groups map[*QueryFilter]int
+}
+
+func newExpressionParser(db *Database, expr string) (*expressionParser, error) {
+	p := &expressionParser{db: db, expr: expr, groups: map[*QueryFilter]int{}}
+	if err := p.lex(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *expressionParser) errorf(pos int, format string, args ...interface{}) *ExpressionError {
+	return &ExpressionError{Expression: p.expr, Offset: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *expressionParser) lex() error {
+	s := p.expr
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			p.tokens = append(p.tokens, token{kind: leftParenToken, text: "(", pos: i})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, token{kind: rightParenToken, text: ")", pos: i})
+			i++
+		case r == ',':
+			p.tokens = append(p.tokens, token{kind: commaToken, text: ",", pos: i})
+			i++
+		case strings.ContainsRune("=!<>", r):
+			op := s[i : i+1]
+			if i+1 < len(s) && s[i+1] == '=' {
+				op = s[i : i+2]
+			}
+			if op == "!" {
+				return p.errorf(i, `expected "!=", got "!"`)
+			}
+			p.tokens = append(p.tokens, token{kind: operatorToken, text: op, value: op, pos: i})
+			i += len(op)
+		case r == '"':
+			end := i + 1
+			for end < len(s) && s[end] != '"' {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				return p.errorf(i, "unterminated string")
+			}
+
+			value, err := strconv.Unquote(s[i : end+1])
+			if err != nil {
+				return p.errorf(i, "invalid string %s", s[i:end+1])
+			}
+			p.tokens = append(p.tokens, token{kind: stringToken, text: s[i : end+1], value: value, pos: i})
+			i = end + 1
+		case r == '`':
+			end := strings.IndexByte(s[i+1:], '`')
+			if end < 0 {
+				return p.errorf(i, "unterminated property name")
+			}
+			p.tokens = append(p.tokens, token{kind: nameToken, text: s[i : i+end+2], value: s[i+1 : i+end+1], pos: i})
+			i += end + 2
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9'):
+			end := i + 1
+			for end < len(s) && isWordRune(rune(s[end])) {
+				end++
+			}
+			if _, err := strconv.ParseFloat(s[i:end], 64); err != nil {
+				return p.errorf(i, "invalid number %s", s[i:end])
+			}
+			p.tokens = append(p.tokens, token{kind: numberToken, text: s[i:end], value: s[i:end], pos: i})
+			i = end
+		case isWordRune(r):
+			end := i
+			for end < len(s) {
+				r, size := utf8.DecodeRuneInString(s[end:])
+				if !isWordRune(r) {
+					break
+				}
+				end += size
+			}
+			p.tokens = append(p.tokens, token{kind: wordToken, text: s[i:end], value: s[i:end], pos: i})
+			i = end
+		default:
+			return p.errorf(i, "unexpected character %q", r)
+		}
+	}
+
+	p.tokens = append(p.tokens, token{kind: eofToken, pos: len(s)})
+	return nil
+}
+
+// isWordRune reports whether the rune can be part of a word: property names which
+// are not quoted, keywords, and numbers.
+func isWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune("()=!<>,\"`", r)
+}
+
+func (p *expressionParser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *expressionParser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != eofToken {
+		p.i++
+	}
+
+	return t
+}
+
+func (p *expressionParser) at(kind tokenKind) bool {
+	return p.peek().kind == kind
+}
+
+func (p *expressionParser) atKeyword(keywords ...string) bool {
+	t := p.peek()
+	if t.kind != wordToken {
+		return false
+	}
+
+	for _, k := range keywords {
+		if strings.EqualFold(t.text, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *expressionParser) expectKeyword(keyword string) error {
+	if !p.atKeyword(keyword) {
+		return p.errorf(p.peek().pos, "expected %q, got %s", keyword, p.peek())
+	}
+	p.next()
+
+	return nil
+}
+
+// filter reads a filter, which Notion accepts with compounds nested at most maxFilterDepth levels deep.
+func (p *expressionParser) filter() (*QueryFilter, error) {
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkDepth(f, 1, -1); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// checkDepth reports the first compound nested deeper than maxFilterDepth at the opening parenthesis
+// of its group, or of the innermost group around it, which is pos. Without parentheses, conditions
+// are an Or of Ands, so the compounds which are too deep are always in a group.
+func (p *expressionParser) checkDepth(f *QueryFilter, level, pos int) error {
+	if f.compound == "" {
+		return nil
+	}
+
+	if open, ok := p.groups[f]; ok {
+		pos = open
+	}
+	if level > maxFilterDepth {
+		return p.errorf(pos, "parentheses nest compound filters %d levels deep, at most %d are allowed", level, maxFilterDepth)
+	}
+
+	for _, filter := range f.filters {
+		if err := p.checkDepth(filter, level+1, pos); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *expressionParser) or() (*QueryFilter, error) {
+	f, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.atKeyword("or") {
+		p.next()
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		f = f.Or(right)
+	}
+
+	return f, nil
+}
+
+func (p *expressionParser) and() (*QueryFilter, error) {
+	f, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.atKeyword("and") {
+		p.next()
+		right, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		f = f.And(right)
+	}
+
+	return f, nil
+}
+
+func (p *expressionParser) operand() (*QueryFilter, error) {
+	if !p.at(leftParenToken) {
+		return p.condition()
+	}
+
+	open := p.next()
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.at(rightParenToken) {
+		return nil, p.errorf(p.peek().pos, "expected \")\" closing the one at column %d, got %s", utf8.RuneCountInString(p.expr[:open.pos])+1, p.peek())
+	}
+	p.next()
+
+	if f.compound != "" {
+		p.groups[f] = open.pos
+	}
+
+	return f, nil
+}
+
+// property reads a property name: a quoted name, or the words up to the operator.
+// The first word is always part of the name, so that names may start with a keyword.
+func (p *expressionParser) property(stop func() bool) (string, int, error) {
+	start := p.peek()
+	if start.kind == nameToken {
+		p.next()
+		return start.value, start.pos, nil
+	}
+
+	words := []string{}
+	for p.at(wordToken) && (len(words) == 0 || !stop()) {
+		words = append(words, p.next().text)
+	}
+	if len(words) == 0 {
+		return "", start.pos, p.errorf(start.pos, "expected a property, got %s", start)
+	}
+
+	return strings.Join(words, " "), start.pos, nil
+}
+
+// condition is a comparison of a property to a value, before its type is checked.
+type condition struct {
+	property string
+	schema   PropertySchema
+	op       string
+	opPos    int
+	// value is a string, a float64, a bool, or nil when the operator has no value.
+	value    interface{}
+	valuePos int
+}
+
+func (p *expressionParser) condition() (*QueryFilter, error) {
+	name, pos, err := p.property(func() bool {
+		t := p.peek()
+		return operatorKeywords[strings.ToLower(t.text)]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c := condition{property: name, schema: p.db.Property(name)}
+	if c.schema == nil {
+		return nil, p.errorf(pos, "property %q does not exist in the database", name)
+	}
+
+	c.opPos = p.peek().pos
+	switch {
+	case p.at(operatorToken):
+		c.op = p.next().value
+	case p.atKeyword("is"):
+		p.next()
+		switch {
+		case p.atKeyword("not"):
+			p.next()
+			if err := p.expectKeyword("empty"); err != nil {
+				return nil, err
+			}
+			c.op = "is not empty"
+		case p.atKeyword("empty"):
+			p.next()
+			c.op = "is empty"
+		case p.atKeyword("past", "next"):
+			when := strings.ToLower(p.next().text)
+			if !p.atKeyword("week", "month", "year") {
+				return nil, p.errorf(p.peek().pos, "expected week, month or year, got %s", p.peek())
+			}
+			c.op = when + " " + strings.ToLower(p.next().text)
+		default:
+			return nil, p.errorf(p.peek().pos, "expected empty, not empty, past or next after is, got %s", p.peek())
+		}
+		return p.compile(c)
+	case p.atKeyword("contains"):
+		p.next()
+		c.op = "contains"
+	case p.atKeyword("does"):
+		p.next()
+		if err := p.expectKeyword("not"); err != nil {
+			return nil, err
+		}
+		if err := p.expectKeyword("contain"); err != nil {
+			return nil, err
+		}
+		c.op = "does not contain"
+	case p.atKeyword("starts", "ends"):
+		c.op = strings.ToLower(p.next().text) + " with"
+		if err := p.expectKeyword("with"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, p.errorf(p.peek().pos, "expected an operator after property %q, got %s", name, p.peek())
+	}
+
+	t := p.peek()
+	c.valuePos = t.pos
+	switch {
+	case t.kind == stringToken:
+		c.value = t.value
+	case t.kind == numberToken:
+		n, _ := strconv.ParseFloat(t.value, 64)
+		c.value = n
+	case p.atKeyword("true", "false"):
+		c.value = strings.EqualFold(t.text, "true")
+	default:
+		return nil, p.errorf(t.pos, "expected a value, got %s", t)
+	}
+	p.next()
+
+	return p.compile(c)
+}
+
+// compile returns the filter of the condition, checking it against the type of the property.
+func (p *expressionParser) compile(c condition) (*QueryFilter, error) {
+	w := Where(c.property)
+	switch c.schema.GetType() {
+	case object.TitlePropertyType:
+		return p.text(c, w.Title())
+	case object.TextPropertyType:
+		return p.text(c, w.Text())
+	case object.URLPropertyType:
+		return p.text(c, w.URL())
+	case object.EmailPropertyType:
+		return p.text(c, w.Email())
+	case object.PhoneNumberPropertyType:
+		return p.text(c, w.PhoneNumber())
+	case object.NumberPropertyType:
+		return p.number(c, w.Number())
+	case object.UniqueIDPropertyType:
+		return p.number(c, w.UniqueID())
+	case object.CheckboxPropertyType:
+		return p.checkbox(c, w.Checkbox())
+	case object.SelectPropertyType:
+		return p.option(c, w.Select())
+	case object.StatusPropertyType:
+		return p.option(c, w.Status())
+	case object.MultiSelectPropertyType:
+		return p.contains(c, w.MultiSelect())
+	case object.PeoplePropertyType:
+		return p.contains(c, w.People())
+	case object.RelationPropertyType:
+		return p.contains(c, w.Relation())
+	case object.CreatedByPropertyType:
+		return p.contains(c, w.CreatedBy())
+	case object.LastEditedByPropertyType:
+		return p.contains(c, w.LastEditedBy())
+	case object.DatePropertyType:
+		return p.date(c, w.Date())
+	case object.CreatedTimePropertyType:
+		return p.date(c, w.CreatedTime())
+	case object.LastEditedTimePropertyType:
+		return p.date(c, w.LastEditedTime())
+	case object.FilesPropertyType:
+		switch c.op {
+		case "is empty":
+			return w.Files().IsEmpty(), nil
+		case "is not empty":
+			return w.Files().IsNotEmpty(), nil
+		}
+	case object.VerificationPropertyType:
+		if c.op == "=" {
+			s, err := p.stringValue(c)
+			if err != nil {
+				return nil, err
+			}
+			return w.Verification().Is(VerificationState(s)), nil
+		}
+	case object.FormulaPropertyType:
+		return p.formula(c, w.Formula())
+	case object.RollupPropertyType:
+		return p.rollup(c, w.Rollup())
+	}
+
+	return nil, p.unsupported(c)
+}
+
+func (p *expressionParser) unsupported(c condition) error {
+	return p.errorf(c.opPos, "operator %q can not be used with %s property %q", c.op, c.schema.GetType(), c.property)
+}
+
+func (p *expressionParser) stringValue(c condition) (string, error) {
+	s, ok := c.value.(string)
+	if !ok {
+		return "", p.errorf(c.valuePos, "expected a string for %s property %q", c.schema.GetType(), c.property)
+	}
+
+	return s, nil
+}
+
+func (p *expressionParser) numberValue(c condition) (float64, error) {
+	n, ok := c.value.(float64)
+	if !ok {
+		return 0, p.errorf(c.valuePos, "expected a number for %s property %q", c.schema.GetType(), c.property)
+	}
+
+	return n, nil
+}
+
+func (p *expressionParser) dateValue(c condition) (DateTime, error) {
+	s, ok := c.value.(string)
+	if !ok {
+		return DateTime{}, p.errorf(c.valuePos, "expected a date string for %s property %q", c.schema.GetType(), c.property)
+	}
+
+	d, err := ParseDateTime(s)
+	if err != nil {
+		return DateTime{}, p.errorf(c.valuePos, "%v", err)
+	}
+
+	return d, nil
+}
+
+func (p *expressionParser) text(c condition, tc *TextCondition) (*QueryFilter, error) {
+	switch c.op {
+	case "is empty":
+		return tc.IsEmpty(), nil
+	case "is not empty":
+		return tc.IsNotEmpty(), nil
+	}
+
+	ops := map[string]func(string) *QueryFilter{
+		"=":                tc.Equals,
+		"!=":               tc.DoesNotEqual,
+		"contains":         tc.Contains,
+		"does not contain": tc.DoesNotContain,
+		"starts with":      tc.StartsWith,
+		"ends with":        tc.EndsWith,
+	}
+	op, ok := ops[c.op]
+	if !ok {
+		return nil, p.unsupported(c)
+	}
+
+	s, err := p.stringValue(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return op(s), nil
+}
+
+func (p *expressionParser) number(c condition, nc *NumberCondition) (*QueryFilter, error) {
+	switch c.op {
+	case "is empty":
+		return nc.IsEmpty(), nil
+	case "is not empty":
+		return nc.IsNotEmpty(), nil
+	}
+
+	ops := map[string]func(float64) *QueryFilter{
+		"=":  nc.Equals,
+		"!=": nc.DoesNotEqual,
+		">":  nc.GreaterThan,
+		"<":  nc.LessThan,
+		">=": nc.GreaterThanOrEqualTo,
+		"<=": nc.LessThanOrEqualTo,
+	}
+	op, ok := ops[c.op]
+	if !ok {
+		return nil, p.unsupported(c)
+	}
+
+	n, err := p.numberValue(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return op(n), nil
+}
+
+func (p *expressionParser) checkbox(c condition, cc *CheckboxCondition) (*QueryFilter, error) {
+	if c.op != "=" && c.op != "!=" {
+		return nil, p.unsupported(c)
+	}
+
+	b, ok := c.value.(bool)
+	if !ok {
+		return nil, p.errorf(c.valuePos, "expected true or false for checkbox property %q", c.property)
+	}
+
+	if c.op == "!=" {
+		return cc.DoesNotEqual(b), nil
+	}
+
+	return cc.Equals(b), nil
+}
+
+func (p *expressionParser) option(c condition, sc *SelectCondition) (*QueryFilter, error) {
+	switch c.op {
+	case "is empty":
+		return sc.IsEmpty(), nil
+	case "is not empty":
+		return sc.IsNotEmpty(), nil
+	case "=", "!=":
+	default:
+		return nil, p.unsupported(c)
+	}
+
+	s, err := p.checkOption(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.op == "!=" {
+		return sc.DoesNotEqual(s), nil
+	}
+
+	return sc.Equals(s), nil
+}
+
+func (p *expressionParser) contains(c condition, cc *ContainsCondition) (*QueryFilter, error) {
+	switch c.op {
+	case "is empty":
+		return cc.IsEmpty(), nil
+	case "is not empty":
+		return cc.IsNotEmpty(), nil
+	case "contains", "does not contain":
+	default:
+		return nil, p.unsupported(c)
+	}
+
+	s, err := p.checkOption(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.op == "does not contain" {
+		return cc.DoesNotContain(s), nil
+	}
+
+	return cc.Contains(s), nil
+}
+
+// checkOption returns the string value, which must be an option of select, status and multi-select properties.
+func (p *expressionParser) checkOption(c condition) (string, error) {
+	s, err := p.stringValue(c)
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	switch schema := c.schema.(type) {
+	case *SelectPropertySchema:
+		for _, o := range schema.Select.Options {
+			names = append(names, o.Name)
+		}
+	case *StatusPropertySchema:
+		for _, o := range schema.Status.Options {
+			names = append(names, o.Name)
+		}
+	case *MultiSelectPropertySchema:
+		for _, o := range schema.MultiSelect.Options {
+			names = append(names, o.Name)
+		}
+	default:
+		return s, nil
+	}
+
+	for _, name := range names {
+		if name == s {
+			return s, nil
+		}
+	}
+
+	return "", p.errorf(c.valuePos, "option %q does not exist in property %q", s, c.property)
+}
+
+func (p *expressionParser) date(c condition, dc *DateCondition) (*QueryFilter, error) {
+	relative := map[string]func() *QueryFilter{
+		"is empty":     dc.IsEmpty,
+		"is not empty": dc.IsNotEmpty,
+		"past week":    dc.PastWeek,
+		"past month":   dc.PastMonth,
+		"past year":    dc.PastYear,
+		"next week":    dc.NextWeek,
+		"next month":   dc.NextMonth,
+		"next year":    dc.NextYear,
+	}
+	if op, ok := relative[c.op]; ok {
+		return op(), nil
+	}
+
+	ops := map[string]func(DateTime) *QueryFilter{
+		"=":  dc.Equals,
+		"<":  dc.Before,
+		">":  dc.After,
+		"<=": dc.OnOrBefore,
+		">=": dc.OnOrAfter,
+	}
+	op, ok := ops[c.op]
+	if !ok {
+		return nil, p.unsupported(c)
+	}
+
+	d, err := p.dateValue(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return op(d), nil
+}
+
+// formula filters the formula by the type of the value: numbers, booleans, and strings,
+// which are dates when compared with an ordering operator.
+func (p *expressionParser) formula(c condition, fc *FormulaCondition) (*QueryFilter, error) {
+	switch v := c.value.(type) {
+	case float64:
+		return p.number(c, fc.Number())
+	case bool:
+		return p.checkbox(c, fc.Checkbox())
+	case string:
+		if _, err := ParseDateTime(v); err == nil && strings.ContainsAny(c.op, "<>") {
+			return p.date(c, fc.Date())
+		}
+		return p.text(c, fc.Text())
+	}
+
+	if strings.HasPrefix(c.op, "past") || strings.HasPrefix(c.op, "next") {
+		return p.date(c, fc.Date())
+	}
+
+	return p.text(c, fc.Text())
+}
+
+// rollup filters the rollup as a number or a date, which are the values of rollups aggregating
+// their relation. Rollups showing the related values are not supported.
+func (p *expressionParser) rollup(c condition, rc *RollupCondition) (*QueryFilter, error) {
+	switch c.value.(type) {
+	case float64:
+		return p.number(c, rc.Number())
+	case string:
+		return p.date(c, rc.Date())
+	}
+
+	if strings.HasPrefix(c.op, "past") || strings.HasPrefix(c.op, "next") {
+		return p.date(c, rc.Date())
+	}
+
+	return p.number(c, rc.Number())
+}
+
+// sorts reads a comma separated list of properties, each followed by an optional direction.
+func (p *expressionParser) sorts() ([]Sort, error) {
+	sorts := []Sort{}
+	for {
+		name, pos, err := p.property(func() bool {
+			return p.atKeyword("asc", "desc", "ascending", "descending")
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sort := Sort{Property: name, Direction: Ascending}
+		if p.db.Property(name) == nil {
+			switch name {
+			case string(object.CreatedTimePropertyType), string(object.LastEditedTimePropertyType):
+				sort = Sort{Timestamp: name, Direction: Ascending}
+			default:
+				return nil, p.errorf(pos, "property %q does not exist in the database", name)
+			}
+		}
+
+		if p.atKeyword("desc", "descending") {
+			p.next()
+			sort.Direction = Descending
+		} else if p.atKeyword("asc", "ascending") {
+			p.next()
+		}
+		sorts = append(sorts, sort)
+
+		if !p.at(commaToken) {
+			break
+		}
+		p.next()
+	}
+
+	if !p.at(eofToken) {
+		return nil, p.errorf(p.peek().pos, "unexpected %s", p.peek())
+	}
+
+	return sorts, nil
+}
@@ -0,0 +1,151 @@
+package notion
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+var tasksDatabase = &Database{
+	Object: object.Database,
+	ID:     "bc1211ca-e3f1-4939-ae34-5260b16f627c",
+	Properties: map[string]PropertySchema{
+		"Name":       &TitlePropertySchema{Type: object.TitlePropertyType, ID: "title", Name: "Name"},
+		"Status":     &StatusPropertySchema{Type: object.StatusPropertyType, ID: "st", Name: "Status", Status: StatusConfig{Options: []StatusOption{{Name: "Not started"}, {Name: "Done"}}}},
+		"Points":     &NumberPropertySchema{Type: object.NumberPropertyType, ID: "pt", Name: "Points"},
+		"Owner":      &PeoplePropertySchema{Type: object.PeoplePropertyType, ID: "ow", Name: "Owner"},
+		"Tags":       &MultiSelectPropertySchema{Type: object.MultiSelectPropertyType, ID: "tg", Name: "Tags", MultiSelect: MultiSelectConfig{Options: []MultiSelectOption{{Name: "urgent"}}}},
+		"Due":        &DatePropertySchema{Type: object.DatePropertyType, ID: "du", Name: "Due"},
+		"Done":       &CheckboxPropertySchema{Type: object.CheckboxPropertyType, ID: "dn", Name: "Done"},
+		"Cost":       &FormulaPropertySchema{Type: object.FormulaPropertyType, ID: "co", Name: "Cost"},
+		"Is blocked": &CheckboxPropertySchema{Type: object.CheckboxPropertyType, ID: "bl", Name: "Is blocked"},
+	},
+}
+
+func TestParseQuery(t *testing.T) {
+	tcs := map[string]struct {
+		expr string
+		want string
+	}{
+		"example": {
+			expr: `Status = "Done" AND (Points >= 3 OR Owner is empty)`,
+			want: `{"filter":{"and":[{"property":"Status","status":{"equals":"Done"}},{"or":[{"number":{"greater_than_or_equal_to":3},"property":"Points"},{"people":{"is_empty":true},"property":"Owner"}]}]}}`,
+		},
+		"precedence": {
+			expr: `Done = true or Points < 1 and Points != 0`,
+			want: `{"filter":{"or":[{"checkbox":{"equals":true},"property":"Done"},{"and":[{"number":{"less_than":1},"property":"Points"},{"number":{"does_not_equal":0},"property":"Points"}]}]}}`,
+		},
+		"text operators": {
+			expr: `Name starts with "Fix" AND Name does not contain "typo"`,
+			want: `{"filter":{"and":[{"property":"Name","title":{"starts_with":"Fix"}},{"property":"Name","title":{"does_not_contain":"typo"}}]}}`,
+		},
+		"multi-select": {
+			expr: `Tags contains "urgent"`,
+			want: `{"filter":{"multi_select":{"contains":"urgent"},"property":"Tags"}}`,
+		},
+		"dates": {
+			expr: `Due >= "2021-05-10" AND Due IS NEXT WEEK`,
+			want: `{"filter":{"and":[{"date":{"on_or_after":"2021-05-10"},"property":"Due"},{"date":{"next_week":{}},"property":"Due"}]}}`,
+		},
+		"formula": {
+			expr: `Cost > 10`,
+			want: `{"filter":{"formula":{"number":{"greater_than":10}},"property":"Cost"}}`,
+		},
+		"property names": {
+			expr: "Is blocked = false AND `Points` is not empty",
+			want: `{"filter":{"and":[{"checkbox":{"equals":false},"property":"Is blocked"},{"number":{"is_not_empty":true},"property":"Points"}]}}`,
+		},
+		"property ID": {
+			expr: `pt = 1`,
+			want: `{"filter":{"number":{"equals":1},"property":"pt"}}`,
+		},
+		"sorts": {
+			expr: `Status = "Done" ORDER BY Points DESC, Is blocked, last_edited_time asc`,
+			want: `{"filter":{"property":"Status","status":{"equals":"Done"}},"sorts":[{"property":"Points","direction":"descending"},{"property":"Is blocked","direction":"ascending"},{"direction":"ascending","timestamp":"last_edited_time"}]}`,
+		},
+		"only sorts": {
+			expr: `order by Name`,
+			want: `{"sorts":[{"property":"Name","direction":"ascending"}]}`,
+		},
+		"empty": {
+			expr: ``,
+			want: `{}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			q, err := ParseQuery(tasksDatabase, tc.expr)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			b, err := json.Marshal(q)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(string(b), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestParseQuery_errors(t *testing.T) {
+	tcs := map[string]struct {
+		expr   string
+		column int
+		want   string
+	}{
+		"unknown property":   {`Status = "Done" AND Priority > 1`, 21, `property "Priority" does not exist in the database`},
+		"missing operator":   {`Points 3`, 8, `expected an operator after property "Points", got "3"`},
+		"missing value":      {`Points >= )`, 11, `expected a value, got ")"`},
+		"unclosed paren":     {`(Points > 1 OR Done = true`, 27, `expected ")" closing the one at column 1, got end of expression`},
+		"wrong operator":     {`Done > true`, 6, `operator ">" can not be used with checkbox property "Done"`},
+		"wrong value":        {`Points = "three"`, 10, `expected a number for number property "Points"`},
+		"unknown option":     {`Status = "Doing"`, 10, `option "Doing" does not exist in property "Status"`},
+		"invalid date":       {`Due < "tomorrow"`, 7, `invalid date "tomorrow"`},
+		"unterminated":       {`Name = "Fix`, 8, `unterminated string`},
+		"trailing":           {`Points > 1 )`, 12, `unexpected ")"`},
+		"unknown sort":       {`ORDER BY Points, Rank`, 18, `property "Rank" does not exist in the database`},
+		"multibyte position": {`Name = "日本" AND Size = 1`, 17, `property "Size" does not exist in the database`},
+		"too deep":           {`(Points = 1 OR (Points = 2 AND (Points = 3 OR Points = 4)))`, 32, `parentheses nest compound filters 3 levels deep, at most 2 are allowed`},
+		"too deep in group":  {`Done = true AND (Points = 1 OR Points = 2 AND Points = 3)`, 17, `parentheses nest compound filters 3 levels deep, at most 2 are allowed`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			_, err := ParseQuery(tasksDatabase, tc.expr)
+
+			var eerr *ExpressionError
+			if !errors.As(err, &eerr) {
+				t.Fatalf("expected ExpressionError, got %v", err)
+			}
+
+			if diff := cmp.Diff(eerr.Column(), tc.column); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+			if diff := cmp.Diff(eerr.Message, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestExpressionError_Excerpt(t *testing.T) {
+	_, err := ParseFilter(tasksDatabase, `Points >= )`)
+
+	var eerr *ExpressionError
+	if !errors.As(err, &eerr) {
+		t.Fatalf("expected ExpressionError, got %v", err)
+	}
+
+	want := "Points >= )\n          ^"
+	if diff := cmp.Diff(eerr.Excerpt(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
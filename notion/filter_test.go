@@ -0,0 +1,137 @@
+package notion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQueryFilter_MarshalJSON(t *testing.T) {
+	due, err := ParseDateTime("2021-05-10")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	tcs := map[string]struct {
+		filter *QueryFilter
+		want   string
+	}{
+		"select": {
+			filter: Where("Status").Select().Equals("Done"),
+			want:   `{"property":"Status","select":{"equals":"Done"}}`,
+		},
+		"false checkbox": {
+			filter: Where("Archived").Checkbox().Equals(false),
+			want:   `{"checkbox":{"equals":false},"property":"Archived"}`,
+		},
+		"zero number": {
+			filter: Where("Points").Number().GreaterThan(0),
+			want:   `{"number":{"greater_than":0},"property":"Points"}`,
+		},
+		"date": {
+			filter: Where("Due").Date().OnOrAfter(due),
+			want:   `{"date":{"on_or_after":"2021-05-10"},"property":"Due"}`,
+		},
+		"relative date": {
+			filter: Where("Due").Date().NextWeek(),
+			want:   `{"date":{"next_week":{}},"property":"Due"}`,
+		},
+		"formula": {
+			filter: Where("Cost").Formula().Number().LessThanOrEqualTo(10),
+			want:   `{"formula":{"number":{"less_than_or_equal_to":10}},"property":"Cost"}`,
+		},
+		"rollup": {
+			filter: Where("Meals").Rollup().Any().Text().Contains("soup"),
+			want:   `{"property":"Meals","rollup":{"any":{"text":{"contains":"soup"}}}}`,
+		},
+		"and": {
+			filter: Where("Status").Select().Equals("Done").And(Where("Points").Number().GreaterThan(0)),
+			want:   `{"and":[{"property":"Status","select":{"equals":"Done"}},{"number":{"greater_than":0},"property":"Points"}]}`,
+		},
+		"chained and": {
+			filter: Where("A").Checkbox().Equals(true).And(Where("B").Checkbox().Equals(true)).And(Where("C").Checkbox().Equals(true)),
+			want:   `{"and":[{"checkbox":{"equals":true},"property":"A"},{"checkbox":{"equals":true},"property":"B"},{"checkbox":{"equals":true},"property":"C"}]}`,
+		},
+		"nested": {
+			filter: And(
+				Where("Status").Status().Equals("Done"),
+				Or(
+					Where("Points").Number().GreaterThanOrEqualTo(3),
+					Where("Owner").People().IsEmpty(),
+				),
+			),
+			want: `{"and":[{"property":"Status","status":{"equals":"Done"}},{"or":[{"number":{"greater_than_or_equal_to":3},"property":"Points"},{"people":{"is_empty":true},"property":"Owner"}]}]}`,
+		},
+		"empty compound": {
+			filter: Or(),
+			want:   `{"or":[]}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			b, err := json.Marshal(tc.filter)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(string(b), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestQueryFilter_MarshalJSON_error(t *testing.T) {
+	tcs := map[string]json.Marshaler{
+		"zero":      &QueryFilter{},
+		"typed nil": (*QueryFilter)(nil),
+		"too deep": And(
+			Where("A").Checkbox().Equals(true),
+			Or(
+				Where("B").Checkbox().Equals(true),
+				And(Where("C").Checkbox().Equals(true), Where("D").Checkbox().Equals(true)),
+			),
+		),
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if _, err := tc.MarshalJSON(); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+func TestDatabaseQuery_MarshalJSON_nilFilter(t *testing.T) {
+	var filter *QueryFilter
+	b, err := json.Marshal(&DatabaseQuery{Filter: filter})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(string(b), `{}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestLegacyFilters_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(&DatabaseQuery{
+		Filter: map[CompoundFilterType]FilterObject{
+			OrFilter: []FilterObject{
+				&FormulaFilter{Property: "Cost", Number: &NumberFilter{GreaterThan: 1}},
+				&DataFilter{Property: "Due", NextWeek: struct{}{}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `{"filter":{"or":[{"property":"Cost","number":{"property":"","greater_than":1}},{"property":"Due","next_week":{}}]}}`
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,149 @@
+package notion
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const idLength = 32
+
+// ErrInvalidID is returned when a string can not be parsed as a Notion ID.
+var ErrInvalidID = errors.New("invalid Notion ID")
+
+// PageID is the ID of a Notion page.
+type PageID string
+
+// ParsePageID parses a page ID from a dashed UUID, an undashed ID or a page URL.
+// When the URL points to a page opened from a database (`?p=`), the opened page is returned.
+func ParsePageID(s string) (PageID, error) {
+	id, err := parseID(s, "p")
+	return PageID(id), err
+}
+
+// String returns the ID in the dashed UUID format.
+// IDs which can not be parsed are returned as they are.
+func (id PageID) String() string {
+	return dashedID(string(id))
+}
+
+// BlockID returns the page ID as a block ID, since pages are also blocks.
+func (id PageID) BlockID() BlockID {
+	return BlockID(id)
+}
+
+// DatabaseID is the ID of a Notion database.
+type DatabaseID string
+
+// ParseDatabaseID parses a database ID from a dashed UUID, an undashed ID or a database URL.
+// View parameters (`?v=`) are ignored.
+func ParseDatabaseID(s string) (DatabaseID, error) {
+	id, err := parseID(s, "")
+	return DatabaseID(id), err
+}
+
+// String returns the ID in the dashed UUID format.
+// IDs which can not be parsed are returned as they are.
+func (id DatabaseID) String() string {
+	return dashedID(string(id))
+}
+
+// BlockID is the ID of a Notion block.
+type BlockID string
+
+// ParseBlockID parses a block ID from a dashed UUID, an undashed ID or a block URL.
+// A link to a block (`#<id>`) returns the linked block, otherwise the page itself.
+func ParseBlockID(s string) (BlockID, error) {
+	if u, err := url.Parse(strings.TrimSpace(s)); err == nil && u.Host != "" && u.Fragment != "" {
+		if id, err := normalizeID(u.Fragment); err == nil {
+			return BlockID(id), nil
+		}
+	}
+
+	id, err := parseID(s, "p")
+	return BlockID(id), err
+}
+
+// String returns the ID in the dashed UUID format.
+// IDs which can not be parsed are returned as they are.
+func (id BlockID) String() string {
+	return dashedID(string(id))
+}
+
+// UserID is the ID of a Notion user.
+type UserID string
+
+// ParseUserID parses a user ID from a dashed UUID or an undashed ID.
+func ParseUserID(s string) (UserID, error) {
+	id, err := normalizeID(s)
+	return UserID(id), err
+}
+
+// String returns the ID in the dashed UUID format.
+// IDs which can not be parsed are returned as they are.
+func (id UserID) String() string {
+	return dashedID(string(id))
+}
+
+// dashedID returns s in the dashed UUID format, or as it is when it is not an ID.
+func dashedID(s string) string {
+	if id, err := normalizeID(s); err == nil {
+		return id
+	}
+
+	return s
+}
+
+// parseID extracts the ID from s, which is either an ID or a Notion URL
+// such as https://www.notion.so/workspace/Title-<id>?v=<view>.
+// queryKey names the query parameter which takes precedence over the path when set.
+func parseID(s, queryKey string) (string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		return normalizeID(s)
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidID, s)
+	}
+
+	if queryKey != "" {
+		if v := u.Query().Get(queryKey); v != "" {
+			return normalizeID(v)
+		}
+	}
+
+	segment := strings.TrimSuffix(u.Path, "/")
+	if i := strings.LastIndex(segment, "/"); i >= 0 {
+		segment = segment[i+1:]
+	}
+
+	if id, err := normalizeID(segment); err == nil {
+		return id, nil
+	}
+
+	// Titled URLs append the undashed ID to the title slug: Title-<id>.
+	if len(segment) > idLength {
+		return normalizeID(segment[len(segment)-idLength:])
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrInvalidID, s)
+}
+
+// normalizeID validates a dashed or undashed ID and returns it in the dashed UUID format.
+func normalizeID(s string) (string, error) {
+	raw := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "-", ""))
+	if len(raw) != idLength {
+		return "", fmt.Errorf("%w: %q", ErrInvalidID, s)
+	}
+
+	for _, r := range raw {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidID, s)
+		}
+	}
+
+	return fmt.Sprintf("%s-%s-%s-%s-%s", raw[0:8], raw[8:12], raw[12:16], raw[16:20], raw[20:]), nil
+}
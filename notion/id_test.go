@@ -0,0 +1,176 @@
+package notion
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParsePageID(t *testing.T) {
+	tcs := map[string]struct {
+		input      string
+		want       PageID
+		shouldPass bool
+	}{
+		"dashed": {
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			true,
+		},
+		"undashed": {
+			"B55C9C91384D452B81DBD1EF79372B75",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			true,
+		},
+		"url": {
+			"https://www.notion.so/b55c9c91384d452b81dbd1ef79372b75",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			true,
+		},
+		"url with workspace and title": {
+			"https://www.notion.so/ketion/Meeting-Notes-b55c9c91384d452b81dbd1ef79372b75",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			true,
+		},
+		"url opened from database": {
+			"https://www.notion.so/ketion/668d797c76fa49349b05ad288df2d136?v=4f555b503a9b49cb924c3746f4ca5522&p=b55c9c91384d452b81dbd1ef79372b75",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			true,
+		},
+		"public site": {
+			" https://ketion.notion.site/Meeting-Notes-b55c9c91384d452b81dbd1ef79372b75/ ",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+			true,
+		},
+		"too short": {
+			"b55c9c91-384d-452b-81db",
+			"",
+			false,
+		},
+		"not hex": {
+			"z55c9c91384d452b81dbd1ef79372b75",
+			"",
+			false,
+		},
+		"url without ID": {
+			"https://www.notion.so/ketion/Meeting-Notes",
+			"",
+			false,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePageID(tc.input)
+			if err != nil {
+				if tc.shouldPass {
+					t.Fatalf("failed: %v", err)
+				}
+
+				if !errors.Is(err, ErrInvalidID) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !tc.shouldPass {
+				t.Fatalf("expected error, got %s", got)
+			}
+
+			if got != tc.want {
+				t.Fatalf("got:%s want:%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseDatabaseID(t *testing.T) {
+	tcs := map[string]struct {
+		input string
+		want  DatabaseID
+	}{
+		"view": {
+			"https://www.notion.so/ketion/668d797c76fa49349b05ad288df2d136?v=4f555b503a9b49cb924c3746f4ca5522",
+			"668d797c-76fa-4934-9b05-ad288df2d136",
+		},
+		"titled": {
+			"https://www.notion.so/Grocery-List-668d797c76fa49349b05ad288df2d136",
+			"668d797c-76fa-4934-9b05-ad288df2d136",
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseDatabaseID(tc.input)
+			if err != nil {
+				t.Fatalf("failed: %v", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("got:%s want:%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseBlockID(t *testing.T) {
+	tcs := map[string]struct {
+		input string
+		want  BlockID
+	}{
+		"block link": {
+			"https://www.notion.so/Meeting-Notes-b55c9c91384d452b81dbd1ef79372b75#9bc30ad4937346a584ab0a7845ee52e6",
+			"9bc30ad4-9373-46a5-84ab-0a7845ee52e6",
+		},
+		"page": {
+			"https://www.notion.so/Meeting-Notes-b55c9c91384d452b81dbd1ef79372b75",
+			"b55c9c91-384d-452b-81db-d1ef79372b75",
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseBlockID(tc.input)
+			if err != nil {
+				t.Fatalf("failed: %v", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("got:%s want:%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestID_String(t *testing.T) {
+	tcs := map[string]struct {
+		got  fmt.Stringer
+		want string
+	}{
+		"undashed page":  {PageID("b55c9c91384d452b81dbd1ef79372b75"), "b55c9c91-384d-452b-81db-d1ef79372b75"},
+		"upper database": {DatabaseID("668D797C-76FA-4934-9B05-AD288DF2D136"), "668d797c-76fa-4934-9b05-ad288df2d136"},
+		"dashed block":   {BlockID("9bc30ad4-9373-46a5-84ab-0a7845ee52e6"), "9bc30ad4-9373-46a5-84ab-0a7845ee52e6"},
+		"undashed user":  {UserID("d40e767cd7af4b18a86d55c61f1e39a4"), "d40e767c-d7af-4b18-a86d-55c61f1e39a4"},
+		"not an ID":      {PageID("meal"), "meal"},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.got.String(); got != tc.want {
+				t.Fatalf("got:%s want:%s", got, tc.want)
+			}
+		})
+	}
+}
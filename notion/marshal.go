@@ -0,0 +1,628 @@
+package notion
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const tagName = "notion"
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	userType       = reflect.TypeOf(User{})
+	dateType       = reflect.TypeOf(Date{})
+	textObjectType = reflect.TypeOf(TextObject{})
+)
+
+// FieldTypeError is returned when a struct field can not hold the value of its property.
+type FieldTypeError struct {
+	Field    string
+	Property string
+	Type     object.PropertyType
+	GoType   reflect.Type
+}
+
+// Error implements the error interface
+func (e *FieldTypeError) Error() string {
+	return fmt.Sprintf("field %s: %s property %q can not be mapped to %s", e.Field, e.Type, e.Property, e.GoType)
+}
+
+// NumberFitError is returned when a number property can not be held exactly by
+// an integer field, because it has a fraction or is out of the range of the field.
+type NumberFitError struct {
+	Field    string
+	Property string
+	Number   float64
+	GoType   reflect.Type
+}
+
+// Error implements the error interface
+func (e *NumberFitError) Error() string {
+	return fmt.Sprintf("field %s: number %v of property %q does not fit in %s", e.Field, e.Number, e.Property, e.GoType)
+}
+
+// fieldTag represents the `notion:"Name,type,omitempty"` struct tag.
+type fieldTag struct {
+	name         string
+	propertyType object.PropertyType
+	omitEmpty    bool
+}
+
+type structField struct {
+	index []int
+	name  string
+	tag   fieldTag
+}
+
+func structFields(t reflect.Type) ([]structField, error) {
+	fields := []structField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(tagName)
+		if !ok || tag == "-" || f.PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("field %s: notion tag must be \"Name,type\", got %q", f.Name, tag)
+		}
+
+		ft := fieldTag{
+			name:         parts[0],
+			propertyType: object.PropertyType(parts[1]),
+		}
+		for _, opt := range parts[2:] {
+			if opt == "omitempty" {
+				ft.omitEmpty = true
+			}
+		}
+
+		fields = append(fields, structField{index: f.Index, name: f.Name, tag: ft})
+	}
+
+	return fields, nil
+}
+
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, errors.New("nil pointer passed")
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%s is not a struct", rv.Type())
+	}
+
+	return rv, nil
+}
+
+// UnmarshalPage stores the page properties into the struct pointed to by v.
+// Fields are mapped by the `notion:"Name,type"` tag, where type is the property type
+// such as title, select or multi_select. Pointer fields are set to nil for empty values.
+func UnmarshalPage(page *Page, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("UnmarshalPage requires a non-nil pointer")
+	}
+
+	sv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+
+	fields, err := structFields(sv.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		prop, err := page.property(f.tag.name, f.tag.propertyType)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+
+		value, err := propertyValue(prop)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+
+		if err := assign(sv.FieldByIndex(f.index), value); err != nil {
+			var fit *NumberFitError
+			if errors.As(err, &fit) {
+				fit.Field, fit.Property = f.name, f.tag.name
+				return fit
+			}
+			return &FieldTypeError{Field: f.name, Property: f.tag.name, Type: f.tag.propertyType, GoType: sv.FieldByIndex(f.index).Type()}
+		}
+	}
+
+	return nil
+}
+
+// propertyValue returns the Go value of the property, or nil when it is empty.
+func propertyValue(prop PropertyValue) (interface{}, error) {
+	switch p := prop.(type) {
+	case *PageTitleProperty:
+		return p.Title, nil
+	case *TextProperty:
+		return p.Text, nil
+	case *NumberProperty:
+		if p.Number == nil {
+			return nil, nil
+		}
+		return *p.Number, nil
+	case *SelectProperty:
+		if p.Select == nil {
+			return nil, nil
+		}
+		return *p.Select, nil
+	case *MultiSelectProperty:
+		return p.MultiSelect, nil
+	case *DateProperty:
+		if p.Date == nil || p.Date.Start.IsZero() {
+			return nil, nil
+		}
+		return *p.Date, nil
+	case *PersonProperty:
+		return p.People, nil
+	case *FilesProperty:
+		return fileURLs(p.Files), nil
+	case *CheckboxProperty:
+		return p.Checkbox, nil
+	case *URLProperty:
+		return p.URL, nil
+	case *EmailProperty:
+		return p.Email, nil
+	case *PhoneNumberProperty:
+		return p.PhoneNumber, nil
+	case *RelationProperty:
+		return p.Relation, nil
+	case *CreatedTimeProperty:
+		return timeValue(p.CreatedTime), nil
+	case *LastEditedTimeProperty:
+		return timeValue(p.LastEditedTime), nil
+	case *CreatedByProperty:
+		return userValue(p.CreatedBy), nil
+	case *LastEditedByProperty:
+		return userValue(p.LastEditedBy), nil
+	case *FormulaProperty:
+		return p.Formula.Value(), nil
+	case *RollupProperty:
+		return p.Rollup.Value()
+	case *StatusProperty:
+		if p.Status == nil {
+			return nil, nil
+		}
+		return *p.Status, nil
+	case *UniqueIDProperty:
+		return p.UniqueID, nil
+	case *VerificationProperty:
+		if p.Verification == nil {
+			return nil, nil
+		}
+		return *p.Verification, nil
+	case *ButtonProperty:
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("%s property is not supported", prop.GetType())
+}
+
+func timeValue(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+func userValue(u User) interface{} {
+	if u.ID == "" {
+		return nil
+	}
+	return u
+}
+
+// fileURLs returns the URLs of the files, which are either hosted by Notion or external.
+func fileURLs(files []File) []string {
+	urls := []string{}
+	for _, f := range files {
+		if url := f.URL(); url != "" {
+			urls = append(urls, url)
+		}
+	}
+
+	return urls
+}
+
+// assign sets the Go value of a property to the field.
+func assign(field reflect.Value, value interface{}) error {
+	if field.Kind() == reflect.Ptr && field.Type().Elem() != userType {
+		if value == nil {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+
+		elem := reflect.New(field.Type().Elem())
+		if err := assign(elem.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	}
+
+	if value == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []TextObject:
+		switch {
+		case field.Kind() == reflect.String:
+			field.SetString(plainText(v))
+			return nil
+		case field.Type() == reflect.TypeOf(v):
+			field.Set(reflect.ValueOf(v))
+			return nil
+		}
+	case float64:
+		switch field.Kind() {
+		case reflect.Float32, reflect.Float64:
+			field.SetFloat(v)
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 || field.OverflowInt(int64(v)) {
+				return &NumberFitError{Number: v, GoType: field.Type()}
+			}
+			field.SetInt(int64(v))
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if v != math.Trunc(v) || v < 0 || v >= math.MaxUint64 || field.OverflowUint(uint64(v)) {
+				return &NumberFitError{Number: v, GoType: field.Type()}
+			}
+			field.SetUint(uint64(v))
+			return nil
+		}
+	case SelectOption:
+		if field.Kind() == reflect.String {
+			field.SetString(v.Name)
+			return nil
+		}
+	case StatusOption:
+		if field.Kind() == reflect.String {
+			field.SetString(v.Name)
+			return nil
+		}
+	case UniqueID:
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(v.String())
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			field.SetInt(int64(v.Number))
+			return nil
+		}
+	case []MultiSelectOption:
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String {
+			return setStrings(field, len(v), func(i int) string { return v[i].Name })
+		}
+	case Date:
+		switch field.Type() {
+		case timeType:
+			field.Set(reflect.ValueOf(v.Start.Time))
+			return nil
+		case dateType:
+			field.Set(reflect.ValueOf(v))
+			return nil
+		}
+	case []User:
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String {
+			return setStrings(field, len(v), func(i int) string { return v[i].ID })
+		}
+	case []Relation:
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String {
+			return setStrings(field, len(v), func(i int) string { return v[i].ID })
+		}
+	case bool:
+		if field.Kind() == reflect.Bool {
+			field.SetBool(v)
+			return nil
+		}
+	case string:
+		if field.Kind() == reflect.String {
+			field.SetString(v)
+			return nil
+		}
+	case []interface{}:
+		if field.Kind() == reflect.Slice {
+			values := reflect.MakeSlice(field.Type(), len(v), len(v))
+			for i, e := range v {
+				if err := assign(values.Index(i), e); err != nil {
+					return err
+				}
+			}
+			field.Set(values)
+			return nil
+		}
+	case User:
+		switch {
+		case field.Type() == reflect.PtrTo(userType):
+			field.Set(reflect.ValueOf(&v))
+			return nil
+		case field.Kind() == reflect.String:
+			field.SetString(v.ID)
+			return nil
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Type().ConvertibleTo(field.Type()) {
+		field.Set(rv.Convert(field.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("can not assign %T to %s", value, field.Type())
+}
+
+func setStrings(field reflect.Value, n int, get func(i int) string) error {
+	s := reflect.MakeSlice(field.Type(), n, n)
+	for i := 0; i < n; i++ {
+		s.Index(i).SetString(get(i))
+	}
+	field.Set(s)
+	return nil
+}
+
+// MarshalProperties returns the page properties for the fields of the struct v,
+// to be used in CreatePageRequest and UpdatePageRequest.
+// Fields are mapped by the `notion:"Name,type"` tag. Properties computed by Notion
+// such as formula and created_time are skipped. Nil pointers and zero time.Time
+// values clear the property, unless the omitempty option skips them along with
+// the other zero values. Pointers to zero values, such as a *float64 pointing at 0,
+// are always written.
+func MarshalProperties(v interface{}) (map[string]PropertyValue, error) {
+	sv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := structFields(sv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]PropertyValue{}
+	for _, f := range fields {
+		if readOnlyPropertyTypes[f.tag.propertyType] {
+			continue
+		}
+
+		field := sv.FieldByIndex(f.index)
+		// A pointer to a zero value sets the property to zero, so only nil pointers are omitted.
+		if f.tag.omitEmpty && field.IsZero() {
+			continue
+		}
+
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				p, err := emptyProperty(f.tag.propertyType)
+				if err != nil {
+					return nil, &FieldTypeError{Field: f.name, Property: f.tag.name, Type: f.tag.propertyType, GoType: field.Type()}
+				}
+				properties[f.tag.name] = p
+				continue
+			}
+			field = field.Elem()
+		}
+
+		p, err := marshalProperty(f.tag.propertyType, field)
+		if err != nil {
+			return nil, &FieldTypeError{Field: f.name, Property: f.tag.name, Type: f.tag.propertyType, GoType: field.Type()}
+		}
+		properties[f.tag.name] = p
+	}
+
+	return properties, nil
+}
+
+func marshalProperty(propertyType object.PropertyType, field reflect.Value) (PropertyValue, error) {
+	switch propertyType {
+	case object.TitlePropertyType:
+		text, err := textValue(field)
+		if err != nil {
+			return nil, err
+		}
+		return &PageTitleProperty{Type: propertyType, Title: text}, nil
+	case object.TextPropertyType:
+		text, err := textValue(field)
+		if err != nil {
+			return nil, err
+		}
+		return &TextProperty{Type: propertyType, Text: text}, nil
+	case object.NumberPropertyType:
+		if n, ok := numberValue(field); ok {
+			return &NumberProperty{Type: propertyType, Number: &n}, nil
+		}
+	case object.SelectPropertyType:
+		if field.Kind() == reflect.String {
+			return &SelectProperty{Type: propertyType, Select: &SelectOption{Name: field.String()}}, nil
+		}
+	case object.StatusPropertyType:
+		if field.Kind() == reflect.String {
+			return &StatusProperty{Type: propertyType, Status: &StatusOption{Name: field.String()}}, nil
+		}
+	case object.MultiSelectPropertyType:
+		names, err := stringsValue(field)
+		if err != nil {
+			return nil, err
+		}
+
+		options := []MultiSelectOption{}
+		for _, name := range names {
+			options = append(options, MultiSelectOption{Name: name})
+		}
+		return &MultiSelectProperty{Type: propertyType, MultiSelect: options}, nil
+	case object.DatePropertyType:
+		switch field.Type() {
+		case timeType:
+			return &DateProperty{Type: propertyType, Date: timeDate(field.Interface().(time.Time))}, nil
+		case dateType:
+			date := field.Interface().(Date)
+			if date.Start.IsZero() {
+				return &DateProperty{Type: propertyType}, nil
+			}
+			return &DateProperty{Type: propertyType, Date: &date}, nil
+		}
+	case object.PeoplePropertyType:
+		ids, err := stringsValue(field)
+		if err != nil {
+			return nil, err
+		}
+
+		users := []User{}
+		for _, id := range ids {
+			users = append(users, User{ID: id})
+		}
+		return &PersonProperty{Type: propertyType, People: users}, nil
+	case object.FilesPropertyType:
+		urls, err := stringsValue(field)
+		if err != nil {
+			return nil, err
+		}
+
+		files := []File{}
+		for _, url := range urls {
+			files = append(files, NewExternalFile(url, url))
+		}
+		return &FilesProperty{Type: propertyType, Files: files}, nil
+	case object.CheckboxPropertyType:
+		if field.Kind() == reflect.Bool {
+			return &CheckboxProperty{Type: propertyType, Checkbox: field.Bool()}, nil
+		}
+	case object.URLPropertyType:
+		if field.Kind() == reflect.String {
+			return &URLProperty{Type: propertyType, URL: field.String()}, nil
+		}
+	case object.EmailPropertyType:
+		if field.Kind() == reflect.String {
+			return &EmailProperty{Type: propertyType, Email: field.String()}, nil
+		}
+	case object.PhoneNumberPropertyType:
+		if field.Kind() == reflect.String {
+			return &PhoneNumberProperty{Type: propertyType, PhoneNumber: field.String()}, nil
+		}
+	case object.RelationPropertyType:
+		ids, err := stringsValue(field)
+		if err != nil {
+			return nil, err
+		}
+
+		relations := []Relation{}
+		for _, id := range ids {
+			relations = append(relations, Relation{ID: id})
+		}
+		return &RelationProperty{Type: propertyType, Relation: relations}, nil
+	}
+
+	return nil, fmt.Errorf("can not marshal %s to %s property", field.Type(), propertyType)
+}
+
+// emptyProperty returns the property value which clears a property of the type.
+func emptyProperty(propertyType object.PropertyType) (PropertyValue, error) {
+	switch propertyType {
+	case object.TitlePropertyType:
+		return &PageTitleProperty{Type: propertyType, Title: []TextObject{}}, nil
+	case object.TextPropertyType:
+		return &TextProperty{Type: propertyType, Text: []TextObject{}}, nil
+	case object.NumberPropertyType:
+		return &NumberProperty{Type: propertyType}, nil
+	case object.SelectPropertyType:
+		return &SelectProperty{Type: propertyType}, nil
+	case object.StatusPropertyType:
+		return &StatusProperty{Type: propertyType}, nil
+	case object.MultiSelectPropertyType:
+		return &MultiSelectProperty{Type: propertyType, MultiSelect: []MultiSelectOption{}}, nil
+	case object.DatePropertyType:
+		return &DateProperty{Type: propertyType}, nil
+	case object.PeoplePropertyType:
+		return &PersonProperty{Type: propertyType, People: []User{}}, nil
+	case object.FilesPropertyType:
+		return &FilesProperty{Type: propertyType, Files: []File{}}, nil
+	case object.CheckboxPropertyType:
+		return &CheckboxProperty{Type: propertyType}, nil
+	case object.URLPropertyType:
+		return &URLProperty{Type: propertyType}, nil
+	case object.EmailPropertyType:
+		return &EmailProperty{Type: propertyType}, nil
+	case object.PhoneNumberPropertyType:
+		return &PhoneNumberProperty{Type: propertyType}, nil
+	case object.RelationPropertyType:
+		return &RelationProperty{Type: propertyType, Relation: []Relation{}}, nil
+	}
+
+	return nil, fmt.Errorf("can not clear %s property", propertyType)
+}
+
+// numberValue returns the value of a numeric field as float64.
+func numberValue(field reflect.Value) (float64, bool) {
+	switch field.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return field.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(field.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(field.Uint()), true
+	}
+
+	return 0, false
+}
+
+func textValue(field reflect.Value) ([]TextObject, error) {
+	switch {
+	case field.Kind() == reflect.String:
+		return []TextObject{
+			{Type: TextRichTextType, Text: &Text{Content: field.String()}},
+		}, nil
+	case field.Kind() == reflect.Slice && field.Type().Elem() == textObjectType:
+		return field.Interface().([]TextObject), nil
+	}
+
+	return nil, fmt.Errorf("can not marshal %s to text", field.Type())
+}
+
+func stringsValue(field reflect.Value) ([]string, error) {
+	if field.Kind() != reflect.Slice || field.Type().Elem().Kind() != reflect.String {
+		return nil, fmt.Errorf("can not marshal %s to strings", field.Type())
+	}
+
+	s := make([]string, field.Len())
+	for i := range s {
+		s[i] = field.Index(i).String()
+	}
+
+	return s, nil
+}
+
+// timeDate returns t as a date when it has no time part, otherwise as a date time.
+// The zero time is the empty date.
+func timeDate(t time.Time) *Date {
+	if t.IsZero() {
+		return nil
+	}
+
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+		return NewDate(t)
+	}
+
+	return NewDateTime(t)
+}
@@ -0,0 +1,240 @@
+package notion
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+type task struct {
+	Name      string    `notion:"Name,title"`
+	Notes     *string   `notion:"Notes,text"`
+	Points    int       `notion:"Points,number"`
+	Done      bool      `notion:"Done,checkbox"`
+	Status    *string   `notion:"Status,select"`
+	Tags      []string  `notion:"Tags,multi_select"`
+	Due       time.Time `notion:"Due,date"`
+	Owners    []UserID  `notion:"Owner,people"`
+	BlockedBy []PageID  `notion:"Blocked by,relation"`
+	Link      string    `notion:"Link,url,omitempty"`
+	Ignored   string    `notion:"-"`
+	internal  string
+}
+
+func TestUnmarshalPage(t *testing.T) {
+	p := decodePage(t, getTaskPageJSON())
+
+	got := task{}
+	if err := UnmarshalPage(p, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	notes, status := "uuu", "In progress"
+	want := task{
+		Name:      "Write docs",
+		Notes:     &notes,
+		Points:    3,
+		Done:      true,
+		Status:    &status,
+		Tags:      []string{"docs", "api"},
+		Due:       time.Date(2021, 5, 12, 0, 0, 0, 0, time.UTC),
+		Owners:    []UserID{"d40e767c-d7af-4b18-a86d-55c61f1e39a4"},
+		BlockedBy: []PageID{"3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"},
+		Link:      "https://example.org",
+	}
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(task{})); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestUnmarshalPage_Computed(t *testing.T) {
+	p := decodePage(t, getComputedPageJSON())
+
+	type computed struct {
+		Cost      float64   `notion:"Cost,formula"`
+		Missing   *float64  `notion:"Missing,formula"`
+		Cheap     bool      `notion:"Cheap,formula"`
+		NextTrip  time.Time `notion:"Next trip,formula"`
+		Meals     int       `notion:"Meals,rollup"`
+		MealNames []string  `notion:"Meal names,rollup"`
+	}
+
+	got := computed{}
+	if err := UnmarshalPage(p, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := computed{
+		Cost:      2.5,
+		Cheap:     true,
+		NextTrip:  time.Date(2021, 5, 20, 0, 0, 0, 0, time.UTC),
+		Meals:     3,
+		MealNames: []string{"Salad", "Soup"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestUnmarshalPage_Error(t *testing.T) {
+	p := decodePage(t, getTaskPageJSON())
+
+	tcs := map[string]struct {
+		v     interface{}
+		check func(err error) bool
+	}{
+		"missing property": {
+			&struct {
+				Estimate float64 `notion:"Estimate,number"`
+			}{},
+			func(err error) bool {
+				var e *PropertyNotFoundError
+				return errors.As(err, &e)
+			},
+		},
+		"property type": {
+			&struct {
+				Status string `notion:"Status,multi_select"`
+			}{},
+			func(err error) bool {
+				var e *PropertyTypeError
+				return errors.As(err, &e)
+			},
+		},
+		"field type": {
+			&struct {
+				Points bool `notion:"Points,number"`
+			}{},
+			func(err error) bool {
+				var e *FieldTypeError
+				return errors.As(err, &e) && e.Field == "Points"
+			},
+		},
+		"invalid tag": {
+			&struct {
+				Points float64 `notion:"Points"`
+			}{},
+			func(err error) bool { return err != nil },
+		},
+		"not a pointer": {
+			task{},
+			func(err error) bool { return err != nil },
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if err := UnmarshalPage(p, tc.v); !tc.check(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPage_numberFit(t *testing.T) {
+	p := &Page{Properties: map[string]PropertyValue{
+		"Points": NewNumber(2.5),
+		"Delta":  NewNumber(-1),
+		"Stock":  NewNumber(300),
+	}}
+
+	tcs := map[string]interface{}{
+		"fraction": &struct {
+			Points int `notion:"Points,number"`
+		}{},
+		"negative": &struct {
+			Delta uint `notion:"Delta,number"`
+		}{},
+		"overflow": &struct {
+			Stock int8 `notion:"Stock,number"`
+		}{},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			var e *NumberFitError
+			if err := UnmarshalPage(p, tc); !errors.As(err, &e) {
+				t.Fatalf("expected NumberFitError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMarshalProperties(t *testing.T) {
+	status := "Done"
+	got, err := MarshalProperties(&task{
+		Name:      "Write docs",
+		Points:    5,
+		Status:    &status,
+		Tags:      []string{"docs"},
+		Due:       time.Date(2021, 5, 12, 0, 0, 0, 0, time.UTC),
+		Owners:    []UserID{"d40e767c-d7af-4b18-a86d-55c61f1e39a4"},
+		BlockedBy: []PageID{},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := map[string]PropertyValue{
+		"Name": &PageTitleProperty{
+			Type:  object.TitlePropertyType,
+			Title: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Write docs"}}},
+		},
+		"Notes":  &TextProperty{Type: object.TextPropertyType, Text: []TextObject{}},
+		"Points": NewNumber(5),
+		"Done":   &CheckboxProperty{Type: object.CheckboxPropertyType, Checkbox: false},
+		"Status": &SelectProperty{Type: object.SelectPropertyType, Select: &SelectOption{Name: "Done"}},
+		"Tags": &MultiSelectProperty{
+			Type:        object.MultiSelectPropertyType,
+			MultiSelect: []MultiSelectOption{{Name: "docs"}},
+		},
+		"Due":        &DateProperty{Type: object.DatePropertyType, Date: NewDate(time.Date(2021, 5, 12, 0, 0, 0, 0, time.UTC))},
+		"Owner":      &PersonProperty{Type: object.PeoplePropertyType, People: []User{{ID: "d40e767c-d7af-4b18-a86d-55c61f1e39a4"}}},
+		"Blocked by": &RelationProperty{Type: object.RelationPropertyType, Relation: []Relation{}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestMarshalProperties_empty(t *testing.T) {
+	got, err := MarshalProperties(&struct {
+		Status   *string   `notion:"Status,select"`
+		Points   *float64  `notion:"Points,number"`
+		Due      time.Time `notion:"Due,date"`
+		Estimate *int      `notion:"Estimate,number,omitempty"`
+		Done     *bool     `notion:"Done,checkbox,omitempty"`
+	}{Done: new(bool)})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `{"Done":{"checkbox":false,"type":"checkbox"},"Due":{"date":null,"type":"date"},"Points":{"number":null,"type":"number"},"Status":{"select":null,"type":"select"}}`
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestMarshalProperties_Error(t *testing.T) {
+	_, err := MarshalProperties(struct {
+		Due string `notion:"Due,date"`
+	}{})
+
+	var e *FieldTypeError
+	if !errors.As(err, &e) || e.Property != "Due" {
+		t.Fatalf("expected FieldTypeError, got %v", err)
+	}
+}
@@ -0,0 +1,643 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ketion-so/go-notion/notion/object"
+	"gopkg.in/yaml.v3"
+)
+
+// DatabaseSchema is the desired schema of a database, which a migration brings the live database to.
+//
+// In YAML (or JSON), properties are written like the property schema objects of Notion:
+//
+//	version: 2
+//	title: Groceries
+//	properties:
+//	  Name:
+//	    title: {}
+//	  Unit price:
+//	    number:
+//	      format: dollar
+//	renames:
+//	  Unit price: Price
+type DatabaseSchema struct {
+	// Version is recorded once the schema is applied, so that it is applied only once.
+	// Schemas without a version are applied every time.
+	Version int
+	// Title is the title of the database, left unchanged when it is empty.
+	Title string
+	// Properties are keyed by name. Properties of the database missing from the schema are removed.
+	Properties map[string]PropertySchema
+	// Renames maps the new names of properties to their previous names or IDs, so that the
+	// properties are renamed instead of being removed and added again. Properties whose schema
+	// has an ID are matched by ID, and the title property is always matched.
+	Renames map[string]string
+}
+
+type databaseSchema struct {
+	Version    int                               `yaml:"version"`
+	Title      string                            `yaml:"title"`
+	Properties map[string]map[string]interface{} `yaml:"properties"`
+	Renames    map[string]string                 `yaml:"renames"`
+}
+
+// ParseDatabaseSchema parses a JSON or YAML database schema.
+// The schema needs exactly one title property, as every database has one.
+func ParseDatabaseSchema(data []byte) (*DatabaseSchema, error) {
+	v := databaseSchema{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	schema := &DatabaseSchema{
+		Version:    v.Version,
+		Title:      v.Title,
+		Properties: map[string]PropertySchema{},
+		Renames:    v.Renames,
+	}
+	for name, obj := range v.Properties {
+		if len(obj) != 1 {
+			return nil, fmt.Errorf("property %s: expected a single property type, got %d keys", name, len(obj))
+		}
+
+		var t string
+		for k := range obj {
+			t = k
+		}
+		obj["type"] = t
+		obj["name"] = name
+
+		p, err := convPropertySchema(obj)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		if _, ok := p.(*UnknownProperty); ok {
+			return nil, fmt.Errorf("property %s: unknown property type %s", name, p.GetType())
+		}
+
+		schema.Properties[name] = p
+	}
+
+	titles := 0
+	for _, p := range schema.Properties {
+		if p.GetType() == object.TitlePropertyType {
+			titles++
+		}
+	}
+	if titles != 1 {
+		return nil, fmt.Errorf("database schema has %d title properties, not 1", titles)
+	}
+
+	return schema, nil
+}
+
+// LoadDatabaseSchema reads the JSON or YAML database schema from the file.
+func LoadDatabaseSchema(path string) (*DatabaseSchema, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseDatabaseSchema(b)
+}
+
+// SchemaChangeType is the kind of a change of a migration plan.
+type SchemaChangeType string
+
+const (
+	TitleSchemaChange   SchemaChangeType = "title"
+	AddSchemaChange     SchemaChangeType = "add"
+	RenameSchemaChange  SchemaChangeType = "rename"
+	RetypeSchemaChange  SchemaChangeType = "retype"
+	OptionsSchemaChange SchemaChangeType = "options"
+	ConfigSchemaChange  SchemaChangeType = "config"
+	RemoveSchemaChange  SchemaChangeType = "remove"
+)
+
+// SchemaChange is a change of a migration plan.
+type SchemaChange struct {
+	Type SchemaChangeType
+	// Property is the current name of the property, or the current title for title changes.
+	Property string
+	// Name is the new name of renamed properties, or the new title for title changes.
+	Name string
+	// From and To are the property types of retyped properties.
+	From object.PropertyType
+	To   object.PropertyType
+	// AddedOptions and RemovedOptions are the names of the options of option changes.
+	AddedOptions   []string
+	RemovedOptions []string
+}
+
+// String returns the change as a line of a plan.
+func (c SchemaChange) String() string {
+	switch c.Type {
+	case TitleSchemaChange:
+		return fmt.Sprintf("~ title %q -> %q", c.Property, c.Name)
+	case AddSchemaChange:
+		return fmt.Sprintf("+ %s: %s", c.Property, c.To)
+	case RenameSchemaChange:
+		return fmt.Sprintf("~ %s -> %s", c.Property, c.Name)
+	case RetypeSchemaChange:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Property, c.From, c.To)
+	case OptionsSchemaChange:
+		options := []string{}
+		for _, o := range c.AddedOptions {
+			options = append(options, "+"+o)
+		}
+		for _, o := range c.RemovedOptions {
+			options = append(options, "-"+o)
+		}
+		return fmt.Sprintf("~ %s: options %s", c.Property, strings.Join(options, " "))
+	case ConfigSchemaChange:
+		return fmt.Sprintf("~ %s: %s configuration", c.Property, c.To)
+	case RemoveSchemaChange:
+		return fmt.Sprintf("- %s", c.Property)
+	}
+
+	return fmt.Sprintf("? %s", c.Property)
+}
+
+// MigrationPlan is the list of changes which bring a database to a schema.
+type MigrationPlan struct {
+	DatabaseID DatabaseID
+	Version    int
+	Changes    []SchemaChange
+
+	title   string
+	patches map[string]*PropertyPatch
+}
+
+// String returns the plan with a change per line.
+func (p *MigrationPlan) String() string {
+	if len(p.Changes) == 0 {
+		return "no changes"
+	}
+
+	lines := make([]string, len(p.Changes))
+	for i, c := range p.Changes {
+		lines[i] = c.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Request returns the update request applying the plan, or nil when there is nothing to change.
+func (p *MigrationPlan) Request() *UpdateDatabaseRequest {
+	if len(p.Changes) == 0 {
+		return nil
+	}
+
+	req := &UpdateDatabaseRequest{}
+	if p.title != "" {
+		req.Title = []TextObject{{Type: TextRichTextType, Text: &Text{Content: p.title}}}
+	}
+	if len(p.patches) > 0 {
+		req.Properties = p.patches
+	}
+
+	return req
+}
+
+var schemaChangeOrder = map[SchemaChangeType]int{
+	TitleSchemaChange:   0,
+	RemoveSchemaChange:  1,
+	RenameSchemaChange:  2,
+	RetypeSchemaChange:  3,
+	OptionsSchemaChange: 4,
+	ConfigSchemaChange:  5,
+	AddSchemaChange:     6,
+}
+
+// PlanMigration returns the changes which bring the database to the schema.
+// The title property of the database is never removed, as Notion requires one;
+// it is left as is when the schema has no title property.
+func PlanMigration(db *Database, schema *DatabaseSchema) *MigrationPlan {
+	plan := &MigrationPlan{
+		DatabaseID: DatabaseID(db.ID),
+		Version:    schema.Version,
+		patches:    map[string]*PropertyPatch{},
+	}
+
+	if title := plainText(db.Title); schema.Title != "" && schema.Title != title {
+		plan.title = schema.Title
+		plan.Changes = append(plan.Changes, SchemaChange{Type: TitleSchemaChange, Property: title, Name: schema.Title})
+	}
+
+	matched := map[string]string{}
+	used := map[string]bool{}
+	match := func(name, liveName string) {
+		if _, ok := matched[name]; ok || used[liveName] {
+			return
+		}
+		matched[name] = liveName
+		used[liveName] = true
+	}
+
+	for name, p := range schema.Properties {
+		if id := p.GetID(); id != "" {
+			if liveName := liveProperty(db, id); liveName != "" {
+				match(name, liveName)
+			}
+		}
+	}
+	for name, old := range schema.Renames {
+		if _, ok := schema.Properties[name]; !ok {
+			continue
+		}
+		if liveName := liveProperty(db, old); liveName != "" {
+			match(name, liveName)
+		}
+	}
+	for name, p := range schema.Properties {
+		if p.GetType() != object.TitlePropertyType {
+			continue
+		}
+		for liveName, live := range db.Properties {
+			if live.GetType() == object.TitlePropertyType {
+				match(name, liveName)
+			}
+		}
+	}
+	for name := range schema.Properties {
+		if _, ok := db.Properties[name]; ok {
+			match(name, name)
+		}
+	}
+
+	for name, p := range schema.Properties {
+		liveName, ok := matched[name]
+		if !ok {
+			plan.patch(name, &PropertyPatch{Schema: p})
+			plan.Changes = append(plan.Changes, SchemaChange{Type: AddSchemaChange, Property: name, To: p.GetType()})
+			continue
+		}
+
+		live := db.Properties[liveName]
+		key := live.GetID()
+		if key == "" {
+			key = liveName
+		}
+
+		if liveName != name {
+			plan.patch(key, &PropertyPatch{Name: name})
+			plan.Changes = append(plan.Changes, SchemaChange{Type: RenameSchemaChange, Property: liveName, Name: name})
+		}
+
+		if live.GetType() != p.GetType() {
+			plan.patch(key, &PropertyPatch{Schema: p})
+			plan.Changes = append(plan.Changes, SchemaChange{Type: RetypeSchemaChange, Property: liveName, From: live.GetType(), To: p.GetType()})
+			continue
+		}
+
+		added, removed, changed := diffPropertyConfig(live, p)
+		if !changed {
+			continue
+		}
+		plan.patch(key, &PropertyPatch{Schema: p})
+		if len(added) > 0 || len(removed) > 0 {
+			plan.Changes = append(plan.Changes, SchemaChange{Type: OptionsSchemaChange, Property: liveName, AddedOptions: added, RemovedOptions: removed})
+		} else {
+			plan.Changes = append(plan.Changes, SchemaChange{Type: ConfigSchemaChange, Property: liveName, To: p.GetType()})
+		}
+	}
+
+	for liveName, live := range db.Properties {
+		if used[liveName] || live.GetType() == object.TitlePropertyType {
+			continue
+		}
+
+		key := live.GetID()
+		if key == "" {
+			key = liveName
+		}
+		plan.patches[key] = nil
+		plan.Changes = append(plan.Changes, SchemaChange{Type: RemoveSchemaChange, Property: liveName})
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		a, b := plan.Changes[i], plan.Changes[j]
+		if schemaChangeOrder[a.Type] != schemaChangeOrder[b.Type] {
+			return schemaChangeOrder[a.Type] < schemaChangeOrder[b.Type]
+		}
+		return a.Property < b.Property
+	})
+
+	return plan
+}
+
+// patch merges the patch into the one of the property.
+func (p *MigrationPlan) patch(key string, patch *PropertyPatch) {
+	existing, ok := p.patches[key]
+	if !ok {
+		p.patches[key] = patch
+		return
+	}
+
+	if patch.Name != "" {
+		existing.Name = patch.Name
+	}
+	if patch.Schema != nil {
+		existing.Schema = patch.Schema
+	}
+}
+
+// liveProperty returns the name of the database property with the name or ID, or an empty string.
+func liveProperty(db *Database, key string) string {
+	if _, ok := db.Properties[key]; ok {
+		return key
+	}
+
+	for name, p := range db.Properties {
+		if p.GetID() == key {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// diffPropertyConfig compares the configuration of properties of the same type.
+// Settings missing from the desired configuration, such as option IDs and colors, are not compared,
+// so that select options are kept when the desired property has none. Options are compared by name, and the options added to and removed from the live property are returned.
+func diffPropertyConfig(live, desired PropertySchema) ([]string, []string, bool) {
+	l, lerr := propertySchemaConfig(live)
+	d, derr := propertySchemaConfig(desired)
+	if lerr != nil || derr != nil {
+		return nil, nil, true
+	}
+
+	lm, _ := l.(map[string]interface{})
+	dm, _ := d.(map[string]interface{})
+	if lm == nil || dm == nil {
+		return nil, nil, !configCovers(l, d)
+	}
+
+	var added, removed []string
+	changed := false
+	if dOptions, ok := dm["options"].([]interface{}); ok {
+		lOptions, _ := lm["options"].([]interface{})
+		liveOptions := map[string]interface{}{}
+		for _, o := range lOptions {
+			liveOptions[optionName(o)] = o
+		}
+
+		desiredOptions := map[string]bool{}
+		for _, o := range dOptions {
+			name := optionName(o)
+			desiredOptions[name] = true
+			lo, ok := liveOptions[name]
+			if !ok {
+				added = append(added, name)
+			} else if !configCovers(lo, o) {
+				changed = true
+			}
+		}
+		for _, o := range lOptions {
+			if name := optionName(o); !desiredOptions[name] {
+				removed = append(removed, name)
+			}
+		}
+
+		lm, dm = withoutKey(lm, "options"), withoutKey(dm, "options")
+	}
+
+	changed = changed || len(added) > 0 || len(removed) > 0 || !configCovers(lm, dm)
+	return added, removed, changed
+}
+
+func optionName(o interface{}) string {
+	m, _ := o.(map[string]interface{})
+	name, _ := m["name"].(string)
+	return name
+}
+
+func withoutKey(m map[string]interface{}, key string) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, v := range m {
+		if k != key {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+// configCovers reports whether the live configuration has every setting of the desired one.
+// Null settings are left unspecified, and IDs are compared in any of their formats.
+func configCovers(live, desired interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !configCovers(l[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !configCovers(l[i], d[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		l, ok := live.(string)
+		if !ok {
+			return false
+		}
+		if l == d {
+			return true
+		}
+		lid, lerr := parseID(l, "")
+		did, derr := parseID(d, "")
+		return lerr == nil && derr == nil && lid == did
+	}
+
+	return reflect.DeepEqual(live, desired)
+}
+
+// Plan returns the changes which bring the database to the schema.
+func (s *DatabasesService) Plan(ctx context.Context, databaseID DatabaseID, schema *DatabaseSchema) (*MigrationPlan, error) {
+	db, err := s.Get(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return PlanMigration(db, schema), nil
+}
+
+// Migrate brings the database to the schema and returns the applied plan.
+// When state is not nil, schemas whose version was already applied are skipped,
+// and the version of the schema is recorded once it is applied.
+func (s *DatabasesService) Migrate(ctx context.Context, databaseID DatabaseID, schema *DatabaseSchema, state MigrationState) (*MigrationPlan, error) {
+	id, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return nil, err
+	}
+
+	versioned := state != nil && schema.Version != 0
+	if versioned {
+		applied, err := state.AppliedVersion(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if applied >= schema.Version {
+			return &MigrationPlan{DatabaseID: id, Version: schema.Version}, nil
+		}
+	}
+
+	plan, err := s.Plan(ctx, id, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	if req := plan.Request(); req != nil {
+		if _, err := s.Update(ctx, id, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if versioned {
+		if err := state.SetAppliedVersion(ctx, id, schema.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// MigrationState records the versions of the schemas applied to databases.
+type MigrationState interface {
+	// AppliedVersion returns the version applied to the database, or 0 when none was.
+	AppliedVersion(ctx context.Context, databaseID DatabaseID) (int, error)
+	SetAppliedVersion(ctx context.Context, databaseID DatabaseID, version int) error
+}
+
+// FileMigrationState records the applied versions in a local JSON file, keyed by database ID.
+type FileMigrationState struct {
+	Path string
+}
+
+// AppliedVersion returns the version applied to the database, or 0 when the file does not exist.
+func (s *FileMigrationState) AppliedVersion(ctx context.Context, databaseID DatabaseID) (int, error) {
+	versions, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+
+	return versions[databaseID], nil
+}
+
+// SetAppliedVersion records the version applied to the database.
+func (s *FileMigrationState) SetAppliedVersion(ctx context.Context, databaseID DatabaseID, version int) error {
+	versions, err := s.read()
+	if err != nil {
+		return err
+	}
+	versions[databaseID] = version
+
+	b, err := json.MarshalIndent(versions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.Path, append(b, '\n'), 0o644)
+}
+
+func (s *FileMigrationState) read() (map[DatabaseID]int, error) {
+	versions := map[DatabaseID]int{}
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return versions, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &versions); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+
+	return versions, nil
+}
+
+// PageMigrationState records the version applied to a single database in a number property of a page,
+// such as a page of a database listing the migrated databases.
+// Using it for another database than DatabaseID is an error.
+type PageMigrationState struct {
+	Pages      *PagesService
+	PageID     PageID
+	Property   string
+	DatabaseID DatabaseID
+}
+
+// check returns an error unless the database is the one whose version the page holds.
+func (s *PageMigrationState) check(databaseID DatabaseID) error {
+	want, err := ParseDatabaseID(string(s.DatabaseID))
+	if err != nil {
+		return fmt.Errorf("page migration state database: %w", err)
+	}
+
+	got, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return err
+	}
+
+	if got != want {
+		return fmt.Errorf("page %s holds the migration version of database %s, not %s", s.PageID, want, got)
+	}
+
+	return nil
+}
+
+// AppliedVersion returns the version held by the page property. An empty property holds 0.
+func (s *PageMigrationState) AppliedVersion(ctx context.Context, databaseID DatabaseID) (int, error) {
+	if err := s.check(databaseID); err != nil {
+		return 0, err
+	}
+
+	page, err := s.Pages.Get(ctx, s.PageID)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := page.Number(s.Property)
+	if err != nil || n == nil {
+		return 0, err
+	}
+
+	return int(*n), nil
+}
+
+// SetAppliedVersion writes the version to the page property.
+func (s *PageMigrationState) SetAppliedVersion(ctx context.Context, databaseID DatabaseID, version int) error {
+	if err := s.check(databaseID); err != nil {
+		return err
+	}
+
+	n := float64(version)
+	_, err := s.Pages.UpdateProperties(ctx, s.PageID, &UpdatePageRequest{
+		Properties: map[string]PropertyValue{s.Property: &NumberProperty{Number: &n}},
+	})
+
+	return err
+}
@@ -0,0 +1,244 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const groceriesSchema = `
+version: 2
+title: Groceries
+properties:
+  Name:
+    title: {}
+  Unit price:
+    number:
+      format: dollar
+  Food group:
+    select:
+      options:
+        - name: Vegetable
+        - name: Fruit
+          color: red
+  Stores:
+    text: {}
+  Calories:
+    number: {}
+renames:
+  Unit price: Price
+`
+
+// groceriesDatabase is the live database the groceries schema is migrated from.
+const groceriesDatabase = `{
+	"object": "database",
+	"id": "668d797c-76fa-4934-9b05-ad288df2d136",
+	"title": [{"type": "text", "text": {"content": "Grocery List"}, "plain_text": "Grocery List"}],
+	"properties": {
+		"Title": {"id": "title", "name": "Title", "type": "title", "title": {}},
+		"Price": {"id": "cU^N", "name": "Price", "type": "number", "number": {"format": "dollar"}},
+		"Food group": {"id": "TJmr", "name": "Food group", "type": "select", "select": {"options": [
+			{"id": "96eb622f-4b88-4283-919d-ece2fbed3841", "name": "Vegetable", "color": "green"},
+			{"id": "ae1f7e4a-0dcd-4dbb-9b4a-7a0d1e0a7c2f", "name": "Meat", "color": "brown"}
+		]}},
+		"Stores": {"id": "st", "name": "Stores", "type": "multi_select", "multi_select": {"options": []}},
+		"Meals": {"id": "mxp^", "name": "Meals", "type": "relation", "relation": {"database_id": "9b3d5d3e0b5b4c7b9d8e2f0a4a3f6c21"}}
+	}
+}`
+
+func TestParseDatabaseSchema(t *testing.T) {
+	got, err := ParseDatabaseSchema([]byte(groceriesSchema))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := &DatabaseSchema{
+		Version: 2,
+		Title:   "Groceries",
+		Properties: map[string]PropertySchema{
+			"Name":       &TitlePropertySchema{Type: object.TitlePropertyType, Name: "Name"},
+			"Unit price": &NumberPropertySchema{Type: object.NumberPropertyType, Name: "Unit price", Number: NumberConfig{Format: DollarNumberFormat}},
+			"Food group": &SelectPropertySchema{Type: object.SelectPropertyType, Name: "Food group", Select: SelectConfig{Options: []SelectOption{
+				{Name: "Vegetable"},
+				{Name: "Fruit", Color: RedColor},
+			}}},
+			"Stores":   &TextPropertySchema{Type: object.TextPropertyType, Name: "Stores"},
+			"Calories": &NumberPropertySchema{Type: object.NumberPropertyType, Name: "Calories"},
+		},
+		Renames: map[string]string{"Unit price": "Price"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	for n, s := range map[string]string{
+		"two types":    "properties:\n  Name:\n    title: {}\n    text: {}\n",
+		"unknown type": "properties:\n  Name:\n    title: {}\n  Place:\n    place: {}\n",
+		"no title":     "properties:\n  Stores:\n    text: {}\n",
+		"two titles":   "properties:\n  Name:\n    title: {}\n  Label:\n    title: {}\n",
+	} {
+		if _, err := ParseDatabaseSchema([]byte(s)); err == nil {
+			t.Fatalf("%s: expected error", n)
+		}
+	}
+}
+
+func TestPlanMigration(t *testing.T) {
+	data := database{}
+	if err := json.Unmarshal([]byte(groceriesDatabase), &data); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	db, err := convDatabase(&data)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	schema, err := ParseDatabaseSchema([]byte(groceriesSchema))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	plan := PlanMigration(db, schema)
+	want := `~ title "Grocery List" -> "Groceries"
+- Meals
+~ Price -> Unit price
+~ Title -> Name
+~ Stores: multi_select -> text
+~ Food group: options +Fruit -Meat
++ Calories: number`
+	if diff := cmp.Diff(plan.String(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	b, err := json.Marshal(plan.Request())
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got, wantRequest interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{
+		"title": [{"type": "text", "text": {"content": "Groceries"}}],
+		"properties": {
+			"title": {"name": "Name"},
+			"cU^N": {"name": "Unit price"},
+			"TJmr": {"select": {"options": [{"name": "Vegetable"}, {"name": "Fruit", "color": "red"}]}},
+			"st": {"text": {}},
+			"Calories": {"number": {}},
+			"mxp^": null
+		}
+	}`), &wantRequest); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if diff := cmp.Diff(got, wantRequest); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if plan := PlanMigration(db, &DatabaseSchema{Properties: db.Properties}); plan.Request() != nil {
+		t.Fatalf("unexpected changes: %s", plan)
+	}
+
+	untitled := map[string]PropertySchema{}
+	for name, p := range db.Properties {
+		if p.GetType() != object.TitlePropertyType {
+			untitled[name] = p
+		}
+	}
+	if plan := PlanMigration(db, &DatabaseSchema{Properties: untitled}); plan.Request() != nil {
+		t.Fatalf("unexpected changes: %s", plan)
+	}
+}
+
+func TestDatabasesService_Migrate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := map[string]int{}
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, groceriesDatabaseID), func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method]++
+		fmt.Fprint(w, groceriesDatabase)
+	})
+
+	schema, err := ParseDatabaseSchema([]byte(groceriesSchema))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "migrations.json")
+	state := &FileMigrationState{Path: path}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Databases.Migrate(context.Background(), groceriesDatabaseID, schema, state); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+	}
+
+	if diff := cmp.Diff(requests, map[string]int{http.MethodGet: 1, http.MethodPatch: 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	want := fmt.Sprintf("{\n  %q: 2\n}\n", groceriesDatabaseID)
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestPageMigrationState(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pageID := PageID("251d2b5f-268c-4de2-afe9-c71ff92ca95c")
+	version := 1
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", pagesPath, pageID), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			req := struct {
+				Properties map[string]struct {
+					Number int `json:"number"`
+				} `json:"properties"`
+			}{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("invalid body: %v", err)
+			}
+			version = req.Properties["Schema version"].Number
+		}
+
+		fmt.Fprintf(w, `{"object": "page", "id": "%s", "parent": {"type": "workspace", "workspace": true}, "properties": {
+			"Schema version": {"id": "sv", "type": "number", "number": %d}
+		}}`, pageID, version)
+	})
+
+	state := &PageMigrationState{Pages: client.Pages, PageID: pageID, Property: "Schema version", DatabaseID: groceriesDatabaseID}
+	if err := state.SetAppliedVersion(context.Background(), groceriesDatabaseID, 3); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := state.AppliedVersion(context.Background(), groceriesDatabaseID)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if got != 3 {
+		t.Fatalf("got:%d want:3", got)
+	}
+
+	if _, err := state.AppliedVersion(context.Background(), mealsDatabaseID); err == nil {
+		t.Fatalf("expected error for another database")
+	}
+	if err := state.SetAppliedVersion(context.Background(), mealsDatabaseID, 1); err == nil {
+		t.Fatalf("expected error for another database")
+	}
+	if version != 3 {
+		t.Fatalf("version of another database was written: %d", version)
+	}
+}
@@ -0,0 +1,137 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// PropertyMapper maps a property of the moved page onto the target database schema.
+// It returns the name of the target property and the value to write, or false to drop the property.
+type PropertyMapper func(name string, p PropertyValue, schema map[string]PropertySchema) (string, PropertyValue, bool)
+
+// MoveOptions configures PagesService.Move.
+type MoveOptions struct {
+	// MapProperty is called for the properties which have no column
+	// of the same name and type in the target database.
+	MapProperty PropertyMapper
+}
+
+// MoveIssue describes a property which could not be carried over to the moved page.
+type MoveIssue struct {
+	Property string
+	Reason   string
+}
+
+// MoveResult represents the outcome of PagesService.Move.
+type MoveResult struct {
+	Page    *Page
+	IDs     map[string]string
+	Skipped []MoveIssue
+	// BlockIssues lists the blocks which were skipped or copied elsewhere, as in PagesService.Duplicate.
+	BlockIssues []BlockIssue
+}
+
+// Move re-creates the page and its block tree under newParent, then archives the original page.
+// When newParent is a database, the properties are mapped onto its schema by name and type,
+// falling back to opts.MapProperty for the mismatched ones.
+// The properties which could not be carried over are reported in MoveResult.Skipped,
+// and the blocks in MoveResult.BlockIssues.
+//
+// The original page is left untouched when the copy fails.
+func (s *PagesService) Move(ctx context.Context, pageID PageID, newParent Parent, opts *MoveOptions) (*MoveResult, error) {
+	if opts == nil {
+		opts = &MoveOptions{}
+	}
+
+	src, err := s.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema map[string]PropertySchema
+	if p, ok := newParent.(*DatabaseParent); ok {
+		db, err := s.client.Databases.Get(ctx, DatabaseID(p.DatabaseID))
+		if err != nil {
+			return nil, err
+		}
+		schema = db.Properties
+	}
+
+	result := &MoveResult{}
+	properties := map[string]PropertyValue{}
+	for name, p := range src.Properties {
+		target, value, issue := mapProperty(name, p, schema, opts.MapProperty)
+		if issue == "" {
+			value, issue = writableValue(value)
+		}
+		if issue != "" {
+			result.Skipped = append(result.Skipped, MoveIssue{Property: name, Reason: issue})
+		}
+		if value == nil {
+			continue
+		}
+		properties[target] = value
+	}
+	sort.Slice(result.Skipped, func(i, j int) bool {
+		return result.Skipped[i].Property < result.Skipped[j].Property
+	})
+
+	c := newBlockCopy()
+	result.Page, err = s.copyPage(ctx, src, newParent, properties, c)
+	result.IDs, result.BlockIssues = c.ids, c.issues
+	if err != nil {
+		return result, err
+	}
+
+	archived := true
+	if _, err := s.UpdateProperties(ctx, PageID(src.ID), &UpdatePageRequest{Archived: &archived}); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// mapProperty returns the name and value of the property in the target schema,
+// or the reason why it can not be carried over.
+// A nil schema means the target is not a database, where only the title is kept.
+func mapProperty(name string, p PropertyValue, schema map[string]PropertySchema, mapper PropertyMapper) (string, PropertyValue, string) {
+	if readOnlyPropertyTypes[p.GetType()] {
+		return "", nil, fmt.Sprintf("%s property is read-only", p.GetType())
+	}
+
+	if schema == nil {
+		if p.GetType() == object.TitlePropertyType {
+			return string(object.TitlePropertyType), p, ""
+		}
+		return "", nil, "pages outside of a database only have a title"
+	}
+
+	if column, ok := schema[name]; ok && column.GetType() == p.GetType() {
+		return name, p, ""
+	}
+
+	// Every database has exactly one title column, which may be named differently.
+	if p.GetType() == object.TitlePropertyType {
+		for column, cp := range schema {
+			if cp.GetType() == object.TitlePropertyType {
+				return column, p, ""
+			}
+		}
+	}
+
+	if mapper != nil {
+		if target, value, ok := mapper(name, p, schema); ok {
+			return target, value, ""
+		}
+		return "", nil, "dropped by the property mapper"
+	}
+
+	if _, ok := schema[name]; ok {
+		return "", nil, fmt.Sprintf("target column has a different type than %s", p.GetType())
+	}
+
+	return "", nil, "no such column in the target database"
+}
@@ -0,0 +1,79 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestPagesService_Move(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newFakeWorkspace(t, mux)
+	src := "b55c9c91-384d-452b-81db-d1ef79372b75"
+	databaseID := "668d797c-76fa-4934-9b05-ad288df2d136"
+	w.addPage(src, map[string]interface{}{"type": "workspace", "workspace": true}, `{
+		"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Task"}, "plain_text": "Task"}]},
+		"Tags": {"id": "G~UH", "type": "multi_select", "multi_select": []},
+		"Due": {"id": "YnD", "type": "date", "date": {"start": "2021-05-12"}},
+		"Cost": {"id": "R}wl", "type": "formula", "formula": {"type": "number", "number": 1}}
+	}`)
+	w.addBlock(src, "9bc30ad4-9373-46a5-84ab-0a7845ee52e6", "paragraph", `{"text": [{"type": "text", "text": {"content": "Body"}, "plain_text": "Body"}]}`, false)
+
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, databaseID), func(rw http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(rw, `{
+			"object": "database",
+			"id": "%s",
+			"properties": {
+				"Task": {"id": "title", "type": "title", "title": {}},
+				"Labels": {"id": "lbl", "type": "multi_select", "multi_select": {"options": []}},
+				"Tags": {"id": "tgs", "type": "text", "text": {}}
+			}
+		}`, databaseID)
+	})
+
+	mapper := func(name string, p PropertyValue, schema map[string]PropertySchema) (string, PropertyValue, bool) {
+		if name == "Tags" {
+			return "Labels", p, true
+		}
+		return "", nil, false
+	}
+
+	got, err := client.Pages.Move(context.Background(), PageID(src), &DatabaseParent{DatabaseID: databaseID}, &MoveOptions{MapProperty: mapper})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	wantSkipped := []MoveIssue{
+		{Property: "Cost", Reason: "formula property is read-only"},
+		{Property: "Due", Reason: "dropped by the property mapper"},
+	}
+	if diff := cmp.Diff(got.Skipped, wantSkipped); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	props := w.created[0]["properties"].(map[string]interface{})
+	if _, ok := props["Task"]; !ok {
+		t.Fatalf("title not mapped onto the title column: %v", props)
+	}
+	if _, ok := props["Labels"]; !ok {
+		t.Fatalf("mapped property not written: %v", props)
+	}
+
+	if got.Page.Parent.GetType() != object.DatabaseParentType {
+		t.Fatalf("page not created under the database: %v", got.Page.Parent)
+	}
+
+	if len(w.children[got.Page.ID]) != 1 {
+		t.Fatalf("blocks not copied: %v", w.children[got.Page.ID])
+	}
+
+	if w.updated[src]["archived"] != true {
+		t.Fatalf("original page not archived: %v", w.updated[src])
+	}
+}
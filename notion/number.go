@@ -0,0 +1,246 @@
+package notion
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NumberFormat is how the values of a number property are displayed.
+type NumberFormat string
+
+const (
+	NumberNumberFormat           NumberFormat = "number"
+	NumberWithCommasNumberFormat NumberFormat = "number_with_commas"
+	PercentNumberFormat          NumberFormat = "percent"
+	DollarNumberFormat           NumberFormat = "dollar"
+	CanadianDollarNumberFormat   NumberFormat = "canadian_dollar"
+	SingaporeDollarNumberFormat  NumberFormat = "singapore_dollar"
+	EuroNumberFormat             NumberFormat = "euro"
+	PoundNumberFormat            NumberFormat = "pound"
+	YenNumberFormat              NumberFormat = "yen"
+	RubleNumberFormat            NumberFormat = "ruble"
+	RupeeNumberFormat            NumberFormat = "rupee"
+	WonNumberFormat              NumberFormat = "won"
+	YuanNumberFormat             NumberFormat = "yuan"
+	RealNumberFormat             NumberFormat = "real"
+	LiraNumberFormat             NumberFormat = "lira"
+	RupiahNumberFormat           NumberFormat = "rupiah"
+	FrancNumberFormat            NumberFormat = "franc"
+	HongKongDollarNumberFormat   NumberFormat = "hong_kong_dollar"
+	NewZealandDollarNumberFormat NumberFormat = "new_zealand_dollar"
+	KronaNumberFormat            NumberFormat = "krona"
+	NorwegianKroneNumberFormat   NumberFormat = "norwegian_krone"
+	MexicanPesoNumberFormat      NumberFormat = "mexican_peso"
+	RandNumberFormat             NumberFormat = "rand"
+	NewTaiwanDollarNumberFormat  NumberFormat = "new_taiwan_dollar"
+	DanishKroneNumberFormat      NumberFormat = "danish_krone"
+	ZlotyNumberFormat            NumberFormat = "zloty"
+	BahtNumberFormat             NumberFormat = "baht"
+	ForintNumberFormat           NumberFormat = "forint"
+	KorunaNumberFormat           NumberFormat = "koruna"
+	ShekelNumberFormat           NumberFormat = "shekel"
+	ChileanPesoNumberFormat      NumberFormat = "chilean_peso"
+	PhilippinePesoNumberFormat   NumberFormat = "philippine_peso"
+	DirhamNumberFormat           NumberFormat = "dirham"
+	ColombianPesoNumberFormat    NumberFormat = "colombian_peso"
+	RiyalNumberFormat            NumberFormat = "riyal"
+	RinggitNumberFormat          NumberFormat = "ringgit"
+	LeuNumberFormat              NumberFormat = "leu"
+	ArgentinePesoNumberFormat    NumberFormat = "argentine_peso"
+	UruguayanPesoNumberFormat    NumberFormat = "uruguayan_peso"
+)
+
+// currency is how the amounts of a currency format are displayed.
+type currency struct {
+	// symbol is written before the amount. Currency codes are followed by a space.
+	symbol   string
+	decimals int
+}
+
+// currencies are the currency formats, displayed like Notion does in the en-US locale.
+var currencies = map[NumberFormat]currency{
+	DollarNumberFormat:           {"$", 2},
+	CanadianDollarNumberFormat:   {"CA$", 2},
+	SingaporeDollarNumberFormat:  {"SGD ", 2},
+	EuroNumberFormat:             {"€", 2},
+	PoundNumberFormat:            {"£", 2},
+	YenNumberFormat:              {"¥", 0},
+	RubleNumberFormat:            {"RUB ", 2},
+	RupeeNumberFormat:            {"₹", 2},
+	WonNumberFormat:              {"₩", 0},
+	YuanNumberFormat:             {"CN¥", 2},
+	RealNumberFormat:             {"R$", 2},
+	LiraNumberFormat:             {"TRY ", 2},
+	RupiahNumberFormat:           {"IDR ", 2},
+	FrancNumberFormat:            {"CHF ", 2},
+	HongKongDollarNumberFormat:   {"HK$", 2},
+	NewZealandDollarNumberFormat: {"NZ$", 2},
+	KronaNumberFormat:            {"SEK ", 2},
+	NorwegianKroneNumberFormat:   {"NOK ", 2},
+	MexicanPesoNumberFormat:      {"MX$", 2},
+	RandNumberFormat:             {"ZAR ", 2},
+	NewTaiwanDollarNumberFormat:  {"NT$", 2},
+	DanishKroneNumberFormat:      {"DKK ", 2},
+	ZlotyNumberFormat:            {"PLN ", 2},
+	BahtNumberFormat:             {"THB ", 2},
+	ForintNumberFormat:           {"HUF ", 2},
+	KorunaNumberFormat:           {"CZK ", 2},
+	ShekelNumberFormat:           {"₪", 2},
+	ChileanPesoNumberFormat:      {"CLP ", 0},
+	PhilippinePesoNumberFormat:   {"₱", 2},
+	DirhamNumberFormat:           {"AED ", 2},
+	ColombianPesoNumberFormat:    {"COP ", 2},
+	RiyalNumberFormat:            {"SAR ", 2},
+	RinggitNumberFormat:          {"MYR ", 2},
+	LeuNumberFormat:              {"RON ", 2},
+	ArgentinePesoNumberFormat:    {"ARS ", 2},
+	UruguayanPesoNumberFormat:    {"UYU ", 2},
+}
+
+// Format returns n as Notion displays it in the format.
+// Unknown formats are displayed as plain numbers.
+func (f NumberFormat) Format(n float64) string {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+
+	sign := ""
+	if n < 0 {
+		sign = "-"
+		n = -n
+	}
+
+	switch f {
+	case NumberWithCommasNumberFormat:
+		return sign + groupThousands(strconv.FormatFloat(n, 'f', -1, 64))
+	case PercentNumberFormat:
+		return sign + shiftDecimal(strconv.FormatFloat(n, 'f', -1, 64), 2) + "%"
+	}
+
+	if c, ok := currencies[f]; ok {
+		amount := roundDecimal(strconv.FormatFloat(n, 'f', -1, 64), c.decimals)
+		if sign != "" && strings.Trim(amount, "0.") == "" {
+			sign = ""
+		}
+		return sign + c.symbol + groupThousands(amount)
+	}
+
+	return sign + strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// Parse parses a number displayed in the format. Currency symbols, thousands separators
+// and percent signs are optional, so plain numbers are accepted in every format.
+func (f NumberFormat) Parse(s string) (float64, error) {
+	v := strings.TrimSpace(s)
+
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign = "-"
+		v = v[1:]
+	}
+
+	if c, ok := currencies[f]; ok {
+		v = strings.TrimPrefix(v, strings.TrimSpace(c.symbol))
+	}
+	v = strings.TrimLeft(v, " \u00a0")
+	if strings.HasPrefix(v, "-") && sign == "" {
+		sign = "-"
+		v = v[1:]
+	}
+
+	v = strings.ReplaceAll(v, ",", "")
+	if f == PercentNumberFormat && strings.HasSuffix(v, "%") {
+		v = shiftDecimal(strings.TrimSuffix(v, "%"), -2)
+	}
+
+	n, err := strconv.ParseFloat(sign+v, 64)
+	if err != nil || v == "" || strings.ContainsAny(v, "+-eE") {
+		return 0, fmt.Errorf("invalid %s number %q", f, s)
+	}
+
+	return n, nil
+}
+
+// groupThousands separates the thousands of the integer part of the unsigned decimal with commas.
+func groupThousands(s string) string {
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i:]
+	}
+
+	var b strings.Builder
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String() + fraction
+}
+
+// roundDecimal rounds the unsigned decimal half away from zero to the number of decimals
+// and pads it with zeros. Rounding the shortest representation of the float, instead of
+// its exact binary value, displays 1.005 as 1.01 like Notion does.
+func roundDecimal(s string, decimals int) string {
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	for len(fraction) <= decimals {
+		fraction += "0"
+	}
+
+	digits := []byte(integer + fraction[:decimals])
+	if fraction[decimals] >= '5' {
+		i := len(digits) - 1
+		for ; i >= 0 && digits[i] == '9'; i-- {
+			digits[i] = '0'
+		}
+		if i < 0 {
+			digits = append([]byte{'1'}, digits...)
+		} else {
+			digits[i]++
+		}
+	}
+
+	point := len(digits) - decimals
+	if decimals == 0 {
+		return string(digits)
+	}
+
+	return string(digits[:point]) + "." + string(digits[point:])
+}
+
+// shiftDecimal moves the decimal point of the unsigned decimal by places, to the right
+// when places is positive. It is exact, unlike multiplying the float.
+func shiftDecimal(s string, places int) string {
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	digits := integer + fraction
+	point := len(integer) + places
+	for point > len(digits) {
+		digits += "0"
+	}
+	for point < 1 {
+		digits = "0" + digits
+		point++
+	}
+
+	integer = strings.TrimLeft(digits[:point], "0")
+	if integer == "" {
+		integer = "0"
+	}
+	fraction = strings.TrimRight(digits[point:], "0")
+	if fraction == "" {
+		return integer
+	}
+
+	return integer + "." + fraction
+}
@@ -0,0 +1,92 @@
+package notion
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNumberFormat_Format(t *testing.T) {
+	tcs := map[string]struct {
+		format NumberFormat
+		input  float64
+		want   string
+	}{
+		"number":             {NumberNumberFormat, 1234.5678, "1234.5678"},
+		"number with commas": {NumberWithCommasNumberFormat, 1234567.5, "1,234,567.5"},
+		"small with commas":  {NumberWithCommasNumberFormat, 999, "999"},
+		"percent":            {PercentNumberFormat, 0.07, "7%"},
+		"fraction percent":   {PercentNumberFormat, 0.1234, "12.34%"},
+		"negative percent":   {PercentNumberFormat, -1.5, "-150%"},
+		"dollar":             {DollarNumberFormat, 1234.5, "$1,234.50"},
+		"negative dollar":    {DollarNumberFormat, -0.333, "-$0.33"},
+		"rounded to zero":    {DollarNumberFormat, -0.001, "$0.00"},
+		"rounded half up":    {DollarNumberFormat, 1.005, "$1.01"},
+		"rounded up":         {DollarNumberFormat, 999.999, "$1,000.00"},
+		"euro":               {EuroNumberFormat, 1000000, "€1,000,000.00"},
+		"yen":                {YenNumberFormat, 1234.5, "¥1,235"},
+		"franc":              {FrancNumberFormat, 42, "CHF 42.00"},
+		"unknown":            {NumberFormat("bitcoin"), 0.5, "0.5"},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.format.Format(tc.input), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestNumberFormat_Parse(t *testing.T) {
+	tcs := map[string]struct {
+		format  NumberFormat
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		"number":             {format: NumberNumberFormat, input: "1234.5678", want: 1234.5678},
+		"number with commas": {format: NumberWithCommasNumberFormat, input: "1,234,567.5", want: 1234567.5},
+		"percent":            {format: PercentNumberFormat, input: "7%", want: 0.07},
+		"fraction percent":   {format: PercentNumberFormat, input: "12.34%", want: 0.1234},
+		"dollar":             {format: DollarNumberFormat, input: "$1,234.50", want: 1234.5},
+		"negative dollar":    {format: DollarNumberFormat, input: "-$0.33", want: -0.33},
+		"code":               {format: FrancNumberFormat, input: "CHF 42.00", want: 42},
+		"plain currency":     {format: YenNumberFormat, input: "1235", want: 1235},
+		"other symbol":       {format: EuroNumberFormat, input: "$12", wantErr: true},
+		"exponent":           {format: NumberNumberFormat, input: "1e3", wantErr: true},
+		"empty":              {format: NumberNumberFormat, input: "", wantErr: true},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := tc.format.Parse(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestNumberFormat_RoundTrip(t *testing.T) {
+	for format := range currencies {
+		got, err := format.Parse(format.Format(-1234))
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+
+		if got != -1234 {
+			t.Fatalf("%s: got:%v want:-1234", format, got)
+		}
+	}
+}
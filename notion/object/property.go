@@ -23,4 +23,8 @@ const (
 	CreatedByPropertyType      PropertyType = "created_by"
 	LastEditedTimePropertyType PropertyType = "last_edited_time"
 	LastEditedByPropertyType   PropertyType = "last_edited_by"
+	StatusPropertyType         PropertyType = "status"
+	UniqueIDPropertyType       PropertyType = "unique_id"
+	VerificationPropertyType   PropertyType = "verification"
+	ButtonPropertyType         PropertyType = "button"
 )
@@ -4,6 +4,7 @@ package object
 type Type string
 
 const (
+	Block    Type = "block"
 	Bot      Type = "bot"
 	Database Type = "database"
 	Error    Type = "error"
@@ -11,6 +12,8 @@ const (
 	Person   Type = "person"
 	User     Type = "user"
 	List     Type = "list"
+
+	PropertyItem Type = "property_item"
 )
 
 type BlockType string
@@ -0,0 +1,250 @@
+package notion
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// PropertyNotFoundError is returned when the page has no property of the name.
+type PropertyNotFoundError struct {
+	Name string
+}
+
+// Error implements the error interface
+func (e *PropertyNotFoundError) Error() string {
+	return fmt.Sprintf("property %q not found", e.Name)
+}
+
+// PropertyTypeError is returned when the property is not of the requested type.
+type PropertyTypeError struct {
+	Name string
+	Want object.PropertyType
+	Got  object.PropertyType
+}
+
+// Error implements the error interface
+func (e *PropertyTypeError) Error() string {
+	return fmt.Sprintf("property %q is %s, not %s", e.Name, e.Got, e.Want)
+}
+
+func (p *Page) property(name string, propertyType object.PropertyType) (PropertyValue, error) {
+	prop, ok := p.Properties[name]
+	if !ok {
+		return nil, &PropertyNotFoundError{Name: name}
+	}
+
+	if prop.GetType() != propertyType {
+		return nil, &PropertyTypeError{Name: name, Want: propertyType, Got: prop.GetType()}
+	}
+
+	return prop, nil
+}
+
+// Title returns the plain text of the page title.
+func (p *Page) Title() (string, error) {
+	for _, prop := range p.Properties {
+		if title, ok := prop.(*PageTitleProperty); ok {
+			return plainText(title.Title), nil
+		}
+	}
+
+	return "", &PropertyNotFoundError{Name: string(object.TitlePropertyType)}
+}
+
+// Text returns the plain text of the rich text property.
+func (p *Page) Text(name string) (string, error) {
+	prop, err := p.property(name, object.TextPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return plainText(prop.(*TextProperty).Text), nil
+}
+
+// Number returns the value of the number property, or nil when it is empty.
+func (p *Page) Number(name string) (*float64, error) {
+	prop, err := p.property(name, object.NumberPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return prop.(*NumberProperty).Number, nil
+}
+
+// Checkbox returns the value of the checkbox property.
+func (p *Page) Checkbox(name string) (bool, error) {
+	prop, err := p.property(name, object.CheckboxPropertyType)
+	if err != nil {
+		return false, err
+	}
+
+	return prop.(*CheckboxProperty).Checkbox, nil
+}
+
+// Select returns the name of the selected option, or an empty string when nothing is selected.
+func (p *Page) Select(name string) (string, error) {
+	prop, err := p.property(name, object.SelectPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	if s := prop.(*SelectProperty).Select; s != nil {
+		return s.Name, nil
+	}
+
+	return "", nil
+}
+
+// Status returns the name of the status, or an empty string when there is none.
+func (p *Page) Status(name string) (string, error) {
+	prop, err := p.property(name, object.StatusPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	if s := prop.(*StatusProperty).Status; s != nil {
+		return s.Name, nil
+	}
+
+	return "", nil
+}
+
+// UniqueID returns the unique ID of the page in its database.
+func (p *Page) UniqueID(name string) (UniqueID, error) {
+	prop, err := p.property(name, object.UniqueIDPropertyType)
+	if err != nil {
+		return UniqueID{}, err
+	}
+
+	return prop.(*UniqueIDProperty).UniqueID, nil
+}
+
+// MultiSelect returns the names of the selected options.
+func (p *Page) MultiSelect(name string) ([]string, error) {
+	prop, err := p.property(name, object.MultiSelectPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, option := range prop.(*MultiSelectProperty).MultiSelect {
+		names = append(names, option.Name)
+	}
+
+	return names, nil
+}
+
+// Date returns the start of the date property, or the zero time when the date is empty.
+func (p *Page) Date(name string) (time.Time, error) {
+	start, _, err := p.DateRange(name)
+	return start, err
+}
+
+// DateRange returns the start and the end of the date property.
+// The end is the zero time unless the date is a range.
+func (p *Page) DateRange(name string) (time.Time, time.Time, error) {
+	prop, err := p.property(name, object.DatePropertyType)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	date := prop.(*DateProperty).Date
+	if date == nil {
+		return time.Time{}, time.Time{}, nil
+	}
+
+	if !date.IsRange() {
+		return date.Start.Time, time.Time{}, nil
+	}
+
+	return date.Start.Time, date.End.Time, nil
+}
+
+// People returns the users of the people property.
+func (p *Page) People(name string) ([]User, error) {
+	prop, err := p.property(name, object.PeoplePropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return prop.(*PersonProperty).People, nil
+}
+
+// Relation returns the IDs of the related pages.
+func (p *Page) Relation(name string) ([]PageID, error) {
+	prop, err := p.property(name, object.RelationPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []PageID{}
+	for _, r := range prop.(*RelationProperty).Relation {
+		ids = append(ids, PageID(r.ID))
+	}
+
+	return ids, nil
+}
+
+// URL returns the value of the URL property.
+func (p *Page) URL(name string) (string, error) {
+	prop, err := p.property(name, object.URLPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return prop.(*URLProperty).URL, nil
+}
+
+// Email returns the value of the email property.
+func (p *Page) Email(name string) (string, error) {
+	prop, err := p.property(name, object.EmailPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return prop.(*EmailProperty).Email, nil
+}
+
+// PhoneNumber returns the value of the phone number property.
+func (p *Page) PhoneNumber(name string) (string, error) {
+	prop, err := p.property(name, object.PhoneNumberPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return prop.(*PhoneNumberProperty).PhoneNumber, nil
+}
+
+// Formula returns the result of the formula property as string, float64, bool or Date,
+// or nil when it is empty.
+func (p *Page) Formula(name string) (interface{}, error) {
+	prop, err := p.property(name, object.FormulaPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return prop.(*FormulaProperty).Formula.Value(), nil
+}
+
+// Rollup returns the result of the rollup property as float64, Date or []interface{},
+// or nil when it is empty.
+func (p *Page) Rollup(name string) (interface{}, error) {
+	prop, err := p.property(name, object.RollupPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return prop.(*RollupProperty).Rollup.Value()
+}
+
+func plainText(text []TextObject) string {
+	var b strings.Builder
+	for _, t := range text {
+		b.WriteString(t.PlainText)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,295 @@
+package notion
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func getTaskPageJSON() string {
+	return `{
+		"object": "page",
+		"id": "4f555b50-3a9b-49cb-924c-3746f4ca5522",
+		"parent": {
+			"type": "database_id",
+			"database_id": "e6c6f8ff-c70e-4970-91ba-98f03e0d7fc6"
+		},
+		"properties": {
+			"Name": {
+				"id": "title",
+				"type": "title",
+				"title": [
+					{"type": "text", "text": {"content": "Write "}, "plain_text": "Write "},
+					{"type": "text", "text": {"content": "docs"}, "plain_text": "docs"}
+				]
+			},
+			"Notes": {
+				"id": "Me;J",
+				"type": "text",
+				"text": [{"type": "text", "text": {"content": "uuu"}, "plain_text": "uuu"}]
+			},
+			"Points": {"id": "Pts", "type": "number", "number": 3},
+			"Budget": {"id": "Bdg", "type": "number", "number": null},
+			"Done": {"id": "Dn", "type": "checkbox", "checkbox": true},
+			"Status": {
+				"id": "St",
+				"type": "select",
+				"select": {"id": "96eb622f-4b88-4283-919d-ece2fbed3841", "name": "In progress", "color": "green"}
+			},
+			"Tags": {
+				"id": "G~UH",
+				"type": "multi_select",
+				"multi_select": [
+					{"id": "4b9d25a5-a0ee-49ff-a7f1-9485f4773abf", "name": "docs", "color": "green"},
+					{"id": "5c1e36b6-b1ff-4a00-b8f2-a596f5884bc0", "name": "api", "color": "blue"}
+				]
+			},
+			"Due": {"id": "YnD", "type": "date", "date": {"start": "2021-05-12", "end": "2021-05-14T10:00:00.000+09:00"}},
+			"Owner": {
+				"id": "k?CE",
+				"type": "people",
+				"people": [
+					{"object": "user", "id": "d40e767c-d7af-4b18-a86d-55c61f1e39a4", "type": "person", "name": "Avocado Lovelace", "person": {"email": "avo@example.org"}}
+				]
+			},
+			"Blocked by": {"id": "AiL", "type": "relation", "relation": [{"id": "3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"}]},
+			"Link": {"id": "Lk", "type": "url", "url": "https://example.org"},
+			"Stage": {"id": "Sg", "type": "status", "status": {"id": "b4f1c2d3", "name": "In review", "color": "blue"}},
+			"Task ID": {"id": "TID", "type": "unique_id", "unique_id": {"prefix": "TASK", "number": 42}}
+		}
+	}`
+}
+
+func decodePage(t *testing.T, s string) *Page {
+	t.Helper()
+
+	data := page{}
+	if err := json.Unmarshal([]byte(s), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	p, err := convPage(&data)
+	if err != nil {
+		t.Fatalf("failed to decode page: %v", err)
+	}
+
+	return p
+}
+
+func TestPage_Accessors(t *testing.T) {
+	p := decodePage(t, getTaskPageJSON())
+
+	tcs := map[string]struct {
+		get  func() (interface{}, error)
+		want interface{}
+	}{
+		"title": {
+			func() (interface{}, error) { return p.Title() },
+			"Write docs",
+		},
+		"text": {
+			func() (interface{}, error) { return p.Text("Notes") },
+			"uuu",
+		},
+		"number": {
+			func() (interface{}, error) { return p.Number("Points") },
+			NewNumber(3).Number,
+		},
+		"empty number": {
+			func() (interface{}, error) { return p.Number("Budget") },
+			(*float64)(nil),
+		},
+		"checkbox": {
+			func() (interface{}, error) { return p.Checkbox("Done") },
+			true,
+		},
+		"select": {
+			func() (interface{}, error) { return p.Select("Status") },
+			"In progress",
+		},
+		"multi select": {
+			func() (interface{}, error) { return p.MultiSelect("Tags") },
+			[]string{"docs", "api"},
+		},
+		"date": {
+			func() (interface{}, error) { return p.Date("Due") },
+			time.Date(2021, 5, 12, 0, 0, 0, 0, time.UTC),
+		},
+		"people": {
+			func() (interface{}, error) { return p.People("Owner") },
+			[]User{
+				{
+					Object: object.User,
+					ID:     "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
+					Type:   object.Person,
+					Name:   "Avocado Lovelace",
+					Person: &People{Email: "avo@example.org"},
+				},
+			},
+		},
+		"relation": {
+			func() (interface{}, error) { return p.Relation("Blocked by") },
+			[]PageID{"3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"},
+		},
+		"url": {
+			func() (interface{}, error) { return p.URL("Link") },
+			"https://example.org",
+		},
+		"status": {
+			func() (interface{}, error) { return p.Status("Stage") },
+			"In review",
+		},
+		"unique id": {
+			func() (interface{}, error) {
+				id, err := p.UniqueID("Task ID")
+				return id.String(), err
+			},
+			"TASK-42",
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.get()
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestPage_DateRange(t *testing.T) {
+	p := decodePage(t, getTaskPageJSON())
+
+	_, end, err := p.DateRange("Due")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := time.Date(2021, 5, 14, 1, 0, 0, 0, time.UTC)
+	if !end.Equal(want) {
+		t.Fatalf("got:%v want:%v", end, want)
+	}
+}
+
+func TestPage_Accessors_Error(t *testing.T) {
+	p := decodePage(t, getTaskPageJSON())
+
+	_, err := p.Number("Missing")
+	var notFound *PropertyNotFoundError
+	if !errors.As(err, &notFound) || notFound.Name != "Missing" {
+		t.Fatalf("expected PropertyNotFoundError, got %v", err)
+	}
+
+	_, err = p.Number("Status")
+	var typeErr *PropertyTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected PropertyTypeError, got %v", err)
+	}
+
+	want := &PropertyTypeError{Name: "Status", Want: object.NumberPropertyType, Got: object.SelectPropertyType}
+	if diff := cmp.Diff(typeErr, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func getComputedPageJSON() string {
+	return `{
+		"object": "page",
+		"id": "4f555b50-3a9b-49cb-924c-3746f4ca5522",
+		"parent": {"type": "database_id", "database_id": "e6c6f8ff-c70e-4970-91ba-98f03e0d7fc6"},
+		"properties": {
+			"Label": {"id": "lb", "type": "formula", "formula": {"type": "string", "string": "Kale (3)"}},
+			"Cost": {"id": "p:sC", "type": "formula", "formula": {"type": "number", "number": 2.5}},
+			"Cheap": {"id": "ch", "type": "formula", "formula": {"type": "boolean", "boolean": true}},
+			"Next trip": {"id": "nt", "type": "formula", "formula": {"type": "date", "date": {"start": "2021-05-20"}}},
+			"Missing": {"id": "ms", "type": "formula", "formula": {"type": "number", "number": null}},
+			"Meals": {"id": "Z\\Eh", "type": "rollup", "rollup": {"type": "number", "number": 3, "function": "count"}},
+			"Last meal": {"id": "lm", "type": "rollup", "rollup": {"type": "date", "date": {"start": "2021-05-18"}, "function": "latest_date"}},
+			"Meal names": {"id": "mn", "type": "rollup", "rollup": {"type": "array", "function": "show_original", "array": [
+				{"type": "title", "title": [{"type": "text", "text": {"content": "Salad"}, "plain_text": "Salad"}]},
+				{"type": "title", "title": [{"type": "text", "text": {"content": "Soup"}, "plain_text": "Soup"}]}
+			]}}
+		}
+	}`
+}
+
+func TestPage_FormulaRollup(t *testing.T) {
+	p := decodePage(t, getComputedPageJSON())
+
+	tcs := map[string]struct {
+		get  func() (interface{}, error)
+		want interface{}
+	}{
+		"string formula": {
+			func() (interface{}, error) { return p.Formula("Label") },
+			"Kale (3)",
+		},
+		"number formula": {
+			func() (interface{}, error) { return p.Formula("Cost") },
+			2.5,
+		},
+		"boolean formula": {
+			func() (interface{}, error) { return p.Formula("Cheap") },
+			true,
+		},
+		"date formula": {
+			func() (interface{}, error) { return p.Formula("Next trip") },
+			*NewDate(time.Date(2021, 5, 20, 0, 0, 0, 0, time.UTC)),
+		},
+		"empty formula": {
+			func() (interface{}, error) { return p.Formula("Missing") },
+			nil,
+		},
+		"number rollup": {
+			func() (interface{}, error) { return p.Rollup("Meals") },
+			float64(3),
+		},
+		"date rollup": {
+			func() (interface{}, error) { return p.Rollup("Last meal") },
+			*NewDate(time.Date(2021, 5, 18, 0, 0, 0, 0, time.UTC)),
+		},
+		"array rollup": {
+			func() (interface{}, error) {
+				v, err := p.Rollup("Meal names")
+				if err != nil {
+					return nil, err
+				}
+
+				names := []string{}
+				for _, title := range v.([]interface{}) {
+					names = append(names, plainText(title.([]TextObject)))
+				}
+				return names, nil
+			},
+			[]string{"Salad", "Soup"},
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.get()
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -5,13 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/ketion-so/go-notion/notion/object"
 	"github.com/mitchellh/mapstructure"
 )
 
 const (
-	pagesPath = "pages"
+	pagesPath      = "pages"
+	propertiesPath = "properties"
 )
 
 // PagesService handles communication to Notion Pages API.
@@ -24,12 +26,13 @@ type PagesService service
 // API doc: https://developers.notion.com/reference/get-page
 //go:generate gomodifytags --file $GOFILE --struct Page -add-tags json,mapstructure -w -transform snakecase
 type Page struct {
-	Object         object.Type         `json:"object" mapstructure:"object"`
-	ID             string              `json:"id" mapstructure:"id"`
-	CreatedTime    string              `json:"created_time" mapstructure:"created_time"`
-	LastEditedTime string              `json:"last_edited_time" mapstructure:"last_edited_time"`
-	Parent         Parent              `json:"parent" mapstructure:"parent"`
-	Properties     map[string]Property `json:"properties" mapstructure:"properties"`
+	Object         object.Type              `json:"object" mapstructure:"object"`
+	ID             string                   `json:"id" mapstructure:"id"`
+	CreatedTime    string                   `json:"created_time" mapstructure:"created_time"`
+	LastEditedTime string                   `json:"last_edited_time" mapstructure:"last_edited_time"`
+	Archived       bool                     `json:"archived" mapstructure:"archived"`
+	Parent         Parent                   `json:"parent" mapstructure:"parent"`
+	Properties     map[string]PropertyValue `json:"properties" mapstructure:"properties"`
 }
 
 func (p *Page) GetObject() object.Type {
@@ -41,6 +44,7 @@ type page struct {
 	ID             string                 `json:"id"`
 	CreatedTime    string                 `json:"created_time"`
 	LastEditedTime string                 `json:"last_edited_time"`
+	Archived       bool                   `json:"archived"`
 	Parent         map[string]interface{} `json:"parent"`
 	Properties     map[string]interface{} `json:"properties"`
 }
@@ -92,8 +96,13 @@ func (p *WorkspaceParent) GetType() object.ParentType {
 // Get retrieves a page.
 //
 // API doc: https://developers.notion.com/reference/get-page
-func (s *PagesService) Get(ctx context.Context, pageID string) (*Page, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", pagesPath, pageID))
+func (s *PagesService) Get(ctx context.Context, pageID PageID) (*Page, error) {
+	id, err := ParsePageID(string(pageID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", pagesPath, id))
 	if err != nil {
 		return nil, err
 	}
@@ -104,21 +113,154 @@ func (s *PagesService) Get(ctx context.Context, pageID string) (*Page, error) {
 		return nil, err
 	}
 
-	return convPage(&data)
+	page, err := convPage(&data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(page.ID, page)
+
+	return page, nil
+}
+
+type propertyItemList struct {
+	Object       object.Type              `json:"object" mapstructure:"object"`
+	Results      []map[string]interface{} `json:"results" mapstructure:"results"`
+	NextCursor   string                   `json:"next_cursor" mapstructure:"next_cursor"`
+	HasMore      bool                     `json:"has_more" mapstructure:"has_more"`
+	PropertyItem map[string]interface{}   `json:"property_item" mapstructure:"property_item"`
+}
+
+// GetProperty retrieves a page property item.
+// Paginated properties such as title, rich text, relation, people and rollup
+// are followed to the last page and returned as a single PropertyValue.
+//
+// API doc: https://developers.notion.com/reference/retrieve-a-page-property
+func (s *PagesService) GetProperty(ctx context.Context, pageID PageID, propertyID string) (PropertyValue, error) {
+	id, err := ParsePageID(string(pageID))
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s/%s/%s", pagesPath, id, propertiesPath, url.PathEscape(propertyID))
+
+	var item map[string]interface{}
+	results := []map[string]interface{}{}
+	cursor := ""
+	for {
+		urlStr := path
+		if cursor != "" {
+			urlStr = fmt.Sprintf("%s?start_cursor=%s", path, url.QueryEscape(cursor))
+		}
+
+		resp, err := s.client.get(ctx, urlStr)
+		if err != nil {
+			return nil, err
+		}
+
+		data := map[string]interface{}{}
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if object.Type(fmt.Sprint(data["object"])) != object.List {
+			p, err := convProperty(data)
+			if err != nil {
+				return nil, err
+			}
+			s.client.warn(string(id), p)
+
+			return p, nil
+		}
+
+		var list propertyItemList
+		if err := mapstructure.Decode(data, &list); err != nil {
+			return nil, err
+		}
+
+		item = list.PropertyItem
+		results = append(results, list.Results...)
+		if !list.HasMore || list.NextCursor == "" {
+			break
+		}
+		cursor = list.NextCursor
+	}
+
+	p, err := convPropertyItems(item, results)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(string(id), p)
+
+	return p, nil
+}
+
+func convPropertyItems(item map[string]interface{}, results []map[string]interface{}) (PropertyValue, error) {
+	if item == nil {
+		return nil, errors.New("no property item returned")
+	}
+
+	id, _ := item["id"].(string)
+	propertyType, _ := item["type"].(string)
+	if object.PropertyType(propertyType) == object.RollupPropertyType {
+		rollup := &Rollup{}
+		if err := decode(item["rollup"], rollup); err != nil {
+			return nil, err
+		}
+
+		for _, result := range results {
+			p, err := convProperty(result)
+			if err != nil {
+				return nil, err
+			}
+			rollup.Array = append(rollup.Array, p)
+		}
+
+		return &RollupProperty{
+			Type:   object.RollupPropertyType,
+			ID:     id,
+			Rollup: rollup,
+		}, nil
+	}
+
+	values := []interface{}{}
+	for _, result := range results {
+		values = append(values, result[propertyType])
+	}
+
+	return convProperty(map[string]interface{}{
+		"id":         id,
+		"type":       propertyType,
+		propertyType: values,
+	})
 }
 
 // CreatePageRequest object represents the retrieve page.
 //go:generate gomodifytags --file $GOFILE --struct CreatePageRequest -add-tags json,mapstructure -w -transform snakecase
 type CreatePageRequest struct {
-	Parent     Parent              `json:"parent" mapstructure:"parent"`
-	Properties map[string]Property `json:"properties" mapstructure:"properties"`
-	Children   []Block             `json:"children,omitempty" mapstructure:"children"`
+	Parent     Parent                   `json:"parent" mapstructure:"parent"`
+	Properties map[string]PropertyValue `json:"properties" mapstructure:"properties"`
+	Children   []Block                  `json:"children,omitempty" mapstructure:"children"`
+}
+
+// MarshalJSON encodes the request without the properties computed by Notion, which can not be written.
+func (r *CreatePageRequest) MarshalJSON() ([]byte, error) {
+	type request CreatePageRequest
+	req := request(*r)
+	req.Properties = withoutReadOnly(r.Properties)
+
+	return json.Marshal(&req)
 }
 
 // Create page.
 //
 // API doc: https://developers.notion.com/reference/post-page
 func (s *PagesService) Create(ctx context.Context, preq *CreatePageRequest) (*Page, error) {
+	if err := s.client.validateCreate(ctx, preq); err != nil {
+		return nil, err
+	}
+
 	resp, err := s.client.post(ctx, pagesPath, preq)
 	if err != nil {
 		return nil, err
@@ -130,19 +272,60 @@ func (s *PagesService) Create(ctx context.Context, preq *CreatePageRequest) (*Pa
 		return nil, err
 	}
 
-	return convPage(&data)
+	page, err := convPage(&data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(page.ID, page)
+
+	return page, nil
 }
 
 // UpdatePageRequest object represents the update request
 type UpdatePageRequest struct {
-	Properties map[string]Property `json:"properties" mapstructure:"properties"`
+	Properties map[string]PropertyValue `json:"properties,omitempty" mapstructure:"properties"`
+	Archived   *bool                    `json:"archived,omitempty" mapstructure:"archived"`
+}
+
+// MarshalJSON encodes the request without the properties computed by Notion, which can not be written.
+func (r *UpdatePageRequest) MarshalJSON() ([]byte, error) {
+	type request UpdatePageRequest
+	req := request(*r)
+	req.Properties = withoutReadOnly(r.Properties)
+
+	return json.Marshal(&req)
+}
+
+// withoutReadOnly returns the properties except the ones of readOnlyPropertyTypes.
+func withoutReadOnly(properties map[string]PropertyValue) map[string]PropertyValue {
+	if properties == nil {
+		return nil
+	}
+
+	writable := map[string]PropertyValue{}
+	for name, p := range properties {
+		if !readOnlyPropertyTypes[p.GetType()] {
+			writable[name] = p
+		}
+	}
+
+	return writable
 }
 
 // UpdateProperties page properties.
 //
 // API doc: https://developers.notion.com/reference/patch-page
-func (s *PagesService) UpdateProperties(ctx context.Context, pageID string, ureq *UpdatePageRequest) (*Page, error) {
-	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s", pagesPath, pageID), ureq)
+func (s *PagesService) UpdateProperties(ctx context.Context, pageID PageID, ureq *UpdatePageRequest) (*Page, error) {
+	id, err := ParsePageID(string(pageID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.validateUpdate(ctx, id, ureq); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s", pagesPath, id), ureq)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +336,13 @@ func (s *PagesService) UpdateProperties(ctx context.Context, pageID string, ureq
 		return nil, err
 	}
 
-	return convPage(&data)
+	page, err := convPage(&data)
+	if err != nil {
+		return nil, err
+	}
+	s.client.warn(page.ID, page)
+
+	return page, nil
 }
 
 func convPage(data *page) (*Page, error) {
@@ -183,6 +372,7 @@ func convPage(data *page) (*Page, error) {
 		ID:             data.ID,
 		CreatedTime:    data.CreatedTime,
 		LastEditedTime: data.LastEditedTime,
+		Archived:       data.Archived,
 		Properties:     properties,
 		Parent:         p,
 	}
@@ -95,7 +95,7 @@ func TestPagesService_Get(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id   string
+		id   PageID
 		want *Page
 	}{
 		"ok": {
@@ -303,7 +303,7 @@ func TestPagesService_UpdateProperties(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id    string
+		id    PageID
 		input *UpdatePageRequest
 		want  *Page
 	}{
@@ -319,7 +319,7 @@ func TestPagesService_UpdateProperties(t *testing.T) {
 					Type:       object.DatabaseParentType,
 					DatabaseID: "48f8fee9-cd79-4180-bc2f-ec0398253067",
 				},
-				Properties: map[string]Property{
+				Properties: map[string]PropertyValue{
 					"In stock": &CheckboxProperty{Type: "checkbox", ID: "{>U;", Checkbox: true},
 					"Name": &PageTitleProperty{Type: "title", ID: "title", Title: []TextObject{
 						{
@@ -355,3 +355,116 @@ func TestPagesService_UpdateProperties(t *testing.T) {
 		})
 	}
 }
+
+func getPropertyItemJSON(cursor string) string {
+	switch cursor {
+	case "":
+		return `{
+			"object": "list",
+			"results": [
+				{
+					"object": "property_item",
+					"id": "AiL",
+					"type": "relation",
+					"relation": {
+						"id": "3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"
+					}
+				}
+			],
+			"next_cursor": "cursor-2",
+			"has_more": true,
+			"type": "property_item",
+			"property_item": {
+				"id": "AiL",
+				"next_url": "https://api.notion.com/v1/pages/b55c9c91-384d-452b-81db-d1ef79372b75/properties/AiL?start_cursor=cursor-2",
+				"type": "relation",
+				"relation": {}
+			}
+		}`
+	default:
+		return `{
+			"object": "list",
+			"results": [
+				{
+					"object": "property_item",
+					"id": "AiL",
+					"type": "relation",
+					"relation": {
+						"id": "8a9b1c2d-0e4f-4a6b-9c8d-7e6f5a4b3c2d"
+					}
+				}
+			],
+			"next_cursor": null,
+			"has_more": false,
+			"type": "property_item",
+			"property_item": {
+				"id": "AiL",
+				"next_url": null,
+				"type": "relation",
+				"relation": {}
+			}
+		}`
+	}
+}
+
+func TestPagesService_GetProperty(t *testing.T) {
+	tcs := map[string]struct {
+		propertyID string
+		json       func(cursor string) string
+		want       PropertyValue
+	}{
+		"paginated": {
+			"AiL",
+			getPropertyItemJSON,
+			&RelationProperty{
+				Type: object.RelationPropertyType,
+				ID:   "AiL",
+				Relation: []Relation{
+					{ID: "3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"},
+					{ID: "8a9b1c2d-0e4f-4a6b-9c8d-7e6f5a4b3c2d"},
+				},
+			},
+		},
+		"single": {
+			"Stock",
+			func(string) string {
+				return `{
+					"object": "property_item",
+					"id": "Stock",
+					"type": "checkbox",
+					"checkbox": true
+				}`
+			},
+			&CheckboxProperty{
+				Type:     object.CheckboxPropertyType,
+				ID:       "Stock",
+				Checkbox: true,
+			},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			pageID := PageID("b55c9c91-384d-452b-81db-d1ef79372b75")
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s/%s", pagesPath, pageID, propertiesPath, tc.propertyID), func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get(notionVersionHeader) == "" {
+					t.Fatalf("no notion version header to request")
+				}
+
+				fmt.Fprint(w, tc.json(r.URL.Query().Get("start_cursor")))
+			})
+
+			got, err := client.Pages.GetProperty(context.Background(), pageID, tc.propertyID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
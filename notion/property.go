@@ -1,15 +1,19 @@
 package notion
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/ketion-so/go-notion/notion/object"
 	"github.com/mitchellh/mapstructure"
 )
 
-// Property represents database properties.
-type Property interface {
+// PropertyValue represents the value of a page property.
+type PropertyValue interface {
 	GetType() object.PropertyType
+	isPropertyValue()
 }
 
 // PageTitleProperty object represents Notion title Property.
@@ -17,25 +21,19 @@ type Property interface {
 type PageTitleProperty struct {
 	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID    string              `json:"id,omitempty" mapstructure:"id" `
-	Title []TextObject        `json:"title,omitempty" mapstructure:"title" `
+	Title []TextObject        `json:"title" mapstructure:"title" `
 }
 
 // GetType returns the type of the property.
 func (p *PageTitleProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.TitlePropertyType
 }
 
-// DatabaseTitleProperty object represents Notion title Property.
-//go:generate gomodifytags --file $GOFILE --struct DatabaseTitleProperty -add-tags json,mapstructure -w -transform snakecase
-type DatabaseTitleProperty struct {
-	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type" `
-	ID    string              `json:"id,omitempty" mapstructure:"id" `
-	Title *TextObject         `json:"title,omitempty" mapstructure:"title" `
-}
+func (p *PageTitleProperty) isPropertyValue() {}
 
-// GetType returns the type of the property.
-func (p *DatabaseTitleProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+// MarshalJSON encodes the value keyed by its type.
+func (p *PageTitleProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.TitlePropertyType, p.ID, emptyIfNil(p.Title))
 }
 
 // TextProperty object represents Notion rich text Property.
@@ -43,12 +41,19 @@ func (p *DatabaseTitleProperty) GetType() object.PropertyType {
 type TextProperty struct {
 	Type object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID   string              `json:"id,omitempty" mapstructure:"id" `
-	Text interface{}         `json:"text,omitempty" mapstructure:"text" `
+	Text []TextObject        `json:"text" mapstructure:"text" `
 }
 
 // GetType returns the type of the property.
 func (p *TextProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.TextPropertyType
+}
+
+func (p *TextProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *TextProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.TextPropertyType, p.ID, emptyIfNil(p.Text))
 }
 
 // NumberProperty object represents Notion number Property.
@@ -56,21 +61,32 @@ func (p *TextProperty) GetType() object.PropertyType {
 type NumberProperty struct {
 	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID     string              `json:"id,omitempty" mapstructure:"id" `
-	Format string              `json:"format,omitempty" mapstructure:"format" `
-	Number float64             `json:"number" mapstructure:"format" `
+	Number *float64            `json:"number" mapstructure:"number" `
+}
+
+// NewNumber returns a number property holding v.
+func NewNumber(v float64) *NumberProperty {
+	return &NumberProperty{Type: object.NumberPropertyType, Number: &v}
 }
 
 // GetType returns the type of the property.
 func (p *NumberProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.NumberPropertyType
+}
+
+func (p *NumberProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *NumberProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.NumberPropertyType, p.ID, p.Number)
 }
 
 // SelectProperty object represents Notion select Property.
 //go:generate gomodifytags --file $GOFILE --struct SelectProperty -add-tags json,mapstructure -w -transform snakecase
 type SelectProperty struct {
-	Type    object.PropertyType `json:"type,omitempty" mapstructure:"type" `
-	ID      string              `json:"id,omitempty" mapstructure:"id" `
-	Options []SelectOption      `json:"options" mapstructure:"options" `
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Select *SelectOption       `json:"select" mapstructure:"select" `
 }
 
 // SelectOption object represents Notion select Property.
@@ -83,15 +99,22 @@ type SelectOption struct {
 
 // GetType returns the type of the property.
 func (p *SelectProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.SelectPropertyType
+}
+
+func (p *SelectProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *SelectProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.SelectPropertyType, p.ID, p.Select)
 }
 
 // MultiSelectProperty object represents Notion multi select Property.
 //go:generate gomodifytags --file $GOFILE --struct MultiSelectProperty -add-tags json,mapstructure -w -transform snakecase
 type MultiSelectProperty struct {
-	Type    object.PropertyType `json:"type,omitempty" mapstructure:"type" `
-	ID      string              `json:"id,omitempty" mapstructure:"id" `
-	Options []MultiSelectOption `json:"options,omitempty" mapstructure:"options" `
+	Type        object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID          string              `json:"id,omitempty" mapstructure:"id" `
+	MultiSelect []MultiSelectOption `json:"multi_select" mapstructure:"multi_select" `
 }
 
 // MultiSelectOption object represents Notion select Property.
@@ -104,7 +127,14 @@ type MultiSelectOption struct {
 
 // GetType returns the type of the property.
 func (p *MultiSelectProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.MultiSelectPropertyType
+}
+
+func (p *MultiSelectProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *MultiSelectProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.MultiSelectPropertyType, p.ID, emptyIfNil(p.MultiSelect))
 }
 
 // DateProperty object represents Notion date Property.
@@ -115,15 +145,16 @@ type DateProperty struct {
 	Date *Date               `json:"date" mapstructure:"date"`
 }
 
-// Date represents data object's date
-type Date struct {
-	Start string `json:"start" mapstructure:"start"`
-	End   string `json:"end,omitempty" mapstructure:"end"`
-}
-
 // GetType returns the type of the property.
 func (p *DateProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.DatePropertyType
+}
+
+func (p *DateProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *DateProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.DatePropertyType, p.ID, p.Date)
 }
 
 // PersonProperty object represents Notion people Property.
@@ -131,25 +162,39 @@ func (p *DateProperty) GetType() object.PropertyType {
 type PersonProperty struct {
 	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID     string              `json:"id,omitempty" mapstructure:"id" `
-	People interface{}         `json:"people,omitempty" mapstructure:"people" `
+	People []User              `json:"people" mapstructure:"people" `
 }
 
 // GetType returns the type of the property.
 func (p *PersonProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.PeoplePropertyType
+}
+
+func (p *PersonProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *PersonProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.PeoplePropertyType, p.ID, emptyIfNil(p.People))
 }
 
 // FilesProperty object represents Notion file Property.
 //go:generate gomodifytags --file $GOFILE --struct FilesProperty -add-tags json,mapstructure -w -transform snakecase
 type FilesProperty struct {
-	Type object.PropertyType `json:"type,omitempty" mapstructure:"type" `
-	ID   string              `json:"id,omitempty" mapstructure:"id" `
-	File interface{}         `json:"file,omitempty" mapstructure:"file" `
+	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID    string              `json:"id,omitempty" mapstructure:"id" `
+	Files []File              `json:"files" mapstructure:"files" `
 }
 
 // GetType returns the type of the property.
 func (p *FilesProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.FilesPropertyType
+}
+
+func (p *FilesProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *FilesProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.FilesPropertyType, p.ID, emptyIfNil(p.Files))
 }
 
 // CheckboxProperty object represents Notion CheckboxProperty Property.
@@ -157,12 +202,19 @@ func (p *FilesProperty) GetType() object.PropertyType {
 type CheckboxProperty struct {
 	Type     object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID       string              `json:"id,omitempty" mapstructure:"id" `
-	Checkbox interface{}         `json:"checkbox" mapstructure:"checkbox" `
+	Checkbox bool                `json:"checkbox" mapstructure:"checkbox" `
 }
 
 // GetType returns the type of the property.
 func (p *CheckboxProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.CheckboxPropertyType
+}
+
+func (p *CheckboxProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *CheckboxProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.CheckboxPropertyType, p.ID, p.Checkbox)
 }
 
 // URLProperty object represents Notion text Property.
@@ -170,12 +222,19 @@ func (p *CheckboxProperty) GetType() object.PropertyType {
 type URLProperty struct {
 	Type object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID   string              `json:"id,omitempty" mapstructure:"id" `
-	URL  interface{}         `json:"url,omitempty" mapstructure:"url" `
+	URL  string              `json:"url" mapstructure:"url" `
 }
 
 // GetType returns the type of the property.
 func (p *URLProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.URLPropertyType
+}
+
+func (p *URLProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *URLProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.URLPropertyType, p.ID, nullString(p.URL))
 }
 
 // EmailProperty object represents Notion emailProperty Property.
@@ -183,12 +242,19 @@ func (p *URLProperty) GetType() object.PropertyType {
 type EmailProperty struct {
 	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID    string              `json:"id,omitempty" mapstructure:"id" `
-	Email interface{}         `json:"email" mapstructure:"email" `
+	Email string              `json:"email" mapstructure:"email" `
 }
 
 // GetType returns the type of the property.
 func (p *EmailProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.EmailPropertyType
+}
+
+func (p *EmailProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *EmailProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.EmailPropertyType, p.ID, nullString(p.Email))
 }
 
 // PhoneNumberProperty object represents Notion phone number Property.
@@ -196,25 +262,86 @@ func (p *EmailProperty) GetType() object.PropertyType {
 type PhoneNumberProperty struct {
 	Type        object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID          string              `json:"id,omitempty" mapstructure:"id" `
-	PhoneNumber interface{}         `json:"phone_number,omitempty" mapstructure:"phone_number" `
+	PhoneNumber string              `json:"phone_number" mapstructure:"phone_number" `
 }
 
 // GetType returns the type of the property.
 func (p *PhoneNumberProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.PhoneNumberPropertyType
+}
+
+func (p *PhoneNumberProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *PhoneNumberProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.PhoneNumberPropertyType, p.ID, nullString(p.PhoneNumber))
 }
 
 // FormulaProperty object represents Notion formula Property.
 //go:generate gomodifytags --file $GOFILE --struct FormulaProperty -add-tags json,mapstructure -w -transform snakecase
 type FormulaProperty struct {
-	Type       object.PropertyType `json:"type,omitempty" mapstructure:"type" `
-	ID         string              `json:"id,omitempty" mapstructure:"id" `
-	Expression interface{}         `json:"expression,omitempty" mapstructure:"expression" `
+	Type    object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID      string              `json:"id,omitempty" mapstructure:"id" `
+	Formula *Formula            `json:"formula" mapstructure:"formula" `
 }
 
 // GetType returns the type of the property.
 func (p *FormulaProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.FormulaPropertyType
+}
+
+func (p *FormulaProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *FormulaProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.FormulaPropertyType, p.ID, p.Formula)
+}
+
+// FormulaType is type of the formula result.
+type FormulaType string
+
+const (
+	StringFormulaType  FormulaType = "string"
+	NumberFormulaType  FormulaType = "number"
+	BooleanFormulaType FormulaType = "boolean"
+	DateFormulaType    FormulaType = "date"
+)
+
+// Formula object represents the result of Notion formula.
+// Only the field of the result type is set, and it is nil when the result is empty.
+//go:generate gomodifytags --file $GOFILE --struct Formula -add-tags json,mapstructure -w -transform snakecase
+type Formula struct {
+	Type    FormulaType `json:"type" mapstructure:"type" `
+	String  *string     `json:"string,omitempty" mapstructure:"string" `
+	Number  *float64    `json:"number,omitempty" mapstructure:"number" `
+	Boolean *bool       `json:"boolean,omitempty" mapstructure:"boolean" `
+	Date    *Date       `json:"date,omitempty" mapstructure:"date" `
+}
+
+// Value returns the result as string, float64, bool or Date, or nil when it is empty.
+func (f *Formula) Value() interface{} {
+	switch {
+	case f == nil:
+		return nil
+	case f.Type == StringFormulaType && f.String != nil:
+		return *f.String
+	case f.Type == NumberFormulaType && f.Number != nil:
+		return *f.Number
+	case f.Type == BooleanFormulaType && f.Boolean != nil:
+		return *f.Boolean
+	case f.Type == DateFormulaType && f.Date != nil:
+		return *f.Date
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the result keyed by its type, keeping empty results as null.
+func (f *Formula) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":         f.Type,
+		string(f.Type): f.Value(),
+	})
 }
 
 // RelationProperty object represents Notion relation Property.
@@ -222,20 +349,25 @@ func (p *FormulaProperty) GetType() object.PropertyType {
 type RelationProperty struct {
 	Type     object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID       string              `json:"id,omitempty" mapstructure:"id" `
-	Relation []Relation          `json:"relation,omitempty" mapstructure:"relation" `
+	Relation []Relation          `json:"relation" mapstructure:"relation" `
 }
 
-// Relation object represents Notion relation.
+// Relation object represents a related page.
 //go:generate gomodifytags --file $GOFILE --struct Relation -add-tags json,mapstructure -w -transform snakecase
 type Relation struct {
-	DatabaseID         string `json:"database_id,omitempty" mapstructure:"database_id" `
-	SyncedPropertyName string `json:"synced_property_name,omitempty" mapstructure:"synced_property_name" `
-	SyncedPropertyID   string `json:"synced_property_id,omitempty" mapstructure:"synced_property_id" `
+	ID string `json:"id" mapstructure:"id" `
 }
 
 // GetType returns the type of the property.
 func (p *RelationProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.RelationPropertyType
+}
+
+func (p *RelationProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *RelationProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.RelationPropertyType, p.ID, emptyIfNil(p.Relation))
 }
 
 // RollupProperty object represents Notion rollup Property.
@@ -243,22 +375,89 @@ func (p *RelationProperty) GetType() object.PropertyType {
 type RollupProperty struct {
 	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID     string              `json:"id,omitempty" mapstructure:"id" `
-	Rollup *Rollup             `json:"rollup,omitempty" mapstructure:"rollup" `
+	Rollup *Rollup             `json:"rollup" mapstructure:"rollup" `
 }
 
 // GetType returns the type of the property.
 func (p *RollupProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.RollupPropertyType
 }
 
-// Rollup object represents Notion rollup.
+func (p *RollupProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *RollupProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.RollupPropertyType, p.ID, p.Rollup)
+}
+
+// RollupType is type of the rollup result.
+type RollupType string
+
+const (
+	NumberRollupType RollupType = "number"
+	DateRollupType   RollupType = "date"
+	ArrayRollupType  RollupType = "array"
+)
+
+// Rollup object represents the result of Notion rollup.
+// Only the field of the result type is set. Array holds the values of the rolled up property.
 //go:generate gomodifytags --file $GOFILE --struct Rollup -add-tags json,mapstructure -w -transform snakecase
 type Rollup struct {
-	RelationPropertyName string `json:"relation_property_name,omitempty" mapstructure:"relation_property_name" `
-	RelationPropertyID   string `json:"relation_property_id,omitempty" mapstructure:"relation_property_id" `
-	RollupPropertyName   string `json:"rollup_property_name,omitempty" mapstructure:"rollup_property_name" `
-	RollupPropertyID     string `json:"rollup_property_id,omitempty" mapstructure:"rollup_property_id" `
-	Function             string `json:"function,omitempty" mapstructure:"function" `
+	Type     RollupType      `json:"type" mapstructure:"type" `
+	Function string          `json:"function,omitempty" mapstructure:"function" `
+	Number   *float64        `json:"number,omitempty" mapstructure:"number" `
+	Date     *Date           `json:"date,omitempty" mapstructure:"date" `
+	Array    []PropertyValue `json:"array,omitempty" mapstructure:"-" `
+}
+
+// Value returns the result as float64, Date or a slice of the values of the array,
+// which are converted as by UnmarshalPage. It returns nil when the result is empty.
+func (r *Rollup) Value() (interface{}, error) {
+	switch {
+	case r == nil:
+		return nil, nil
+	case r.Type == NumberRollupType && r.Number != nil:
+		return *r.Number, nil
+	case r.Type == DateRollupType && r.Date != nil:
+		return *r.Date, nil
+	case r.Type == ArrayRollupType:
+		values := []interface{}{}
+		for _, p := range r.Array {
+			v, err := propertyValue(p)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	}
+
+	return nil, nil
+}
+
+// MarshalJSON encodes the result keyed by its type, keeping empty results as null.
+func (r *Rollup) MarshalJSON() ([]byte, error) {
+	v := map[string]interface{}{
+		"type": r.Type,
+	}
+	if r.Function != "" {
+		v["function"] = r.Function
+	}
+
+	switch r.Type {
+	case NumberRollupType:
+		v[string(r.Type)] = r.Number
+	case DateRollupType:
+		v[string(r.Type)] = r.Date
+	case ArrayRollupType:
+		array := r.Array
+		if array == nil {
+			array = []PropertyValue{}
+		}
+		v[string(r.Type)] = array
+	}
+
+	return json.Marshal(v)
 }
 
 // CreatedTimeProperty object represents Notion created time Property.
@@ -266,12 +465,19 @@ type Rollup struct {
 type CreatedTimeProperty struct {
 	Type        object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID          string              `json:"id,omitempty" mapstructure:"id" `
-	CreatedTime interface{}         `json:"created_time,omitempty" mapstructure:"created_time" `
+	CreatedTime time.Time           `json:"created_time" mapstructure:"created_time" `
 }
 
 // GetType returns the type of the property.
 func (p *CreatedTimeProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.CreatedTimePropertyType
+}
+
+func (p *CreatedTimeProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *CreatedTimeProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.CreatedTimePropertyType, p.ID, p.CreatedTime)
 }
 
 // CreatedByProperty object represents Notion created by Property.
@@ -279,12 +485,19 @@ func (p *CreatedTimeProperty) GetType() object.PropertyType {
 type CreatedByProperty struct {
 	Type      object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID        string              `json:"id,omitempty" mapstructure:"id" `
-	CreatedBy interface{}         `json:"created_by,omitempty" mapstructure:"created_by" `
+	CreatedBy User                `json:"created_by" mapstructure:"created_by" `
 }
 
 // GetType returns the type of the property.
 func (p *CreatedByProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.CreatedByPropertyType
+}
+
+func (p *CreatedByProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *CreatedByProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.CreatedByPropertyType, p.ID, p.CreatedBy)
 }
 
 // LastEditedTimeProperty object represents Notion last edited time Property.
@@ -292,12 +505,19 @@ func (p *CreatedByProperty) GetType() object.PropertyType {
 type LastEditedTimeProperty struct {
 	Type           object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID             string              `json:"id,omitempty" mapstructure:"id" `
-	LastEditedTime interface{}         `json:"last_edited_time,omitempty" mapstructure:"last_edited_time" `
+	LastEditedTime time.Time           `json:"last_edited_time" mapstructure:"last_edited_time" `
 }
 
 // GetType returns the type of the property.
 func (p *LastEditedTimeProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.LastEditedTimePropertyType
+}
+
+func (p *LastEditedTimeProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *LastEditedTimeProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.LastEditedTimePropertyType, p.ID, p.LastEditedTime)
 }
 
 // LastEditedByProperty object represents Notion last edited by Property.
@@ -305,70 +525,146 @@ func (p *LastEditedTimeProperty) GetType() object.PropertyType {
 type LastEditedByProperty struct {
 	Type         object.PropertyType `json:"type,omitempty" mapstructure:"type" `
 	ID           string              `json:"id,omitempty" mapstructure:"id" `
-	LastEditedBy interface{}         `json:"last_edited_by,omitempty" mapstructure:"last_edited_by" `
+	LastEditedBy User                `json:"last_edited_by" mapstructure:"last_edited_by" `
 }
 
 // GetType returns the type of the property.
 func (p *LastEditedByProperty) GetType() object.PropertyType {
-	return object.PropertyType(p.Type)
+	return object.LastEditedByPropertyType
+}
+
+func (p *LastEditedByProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *LastEditedByProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.LastEditedByPropertyType, p.ID, p.LastEditedBy)
 }
 
-func convProperties(input map[string]interface{}) (map[string]Property, error) {
-	properties := map[string]Property{}
+// StatusProperty object represents Notion status Property.
+//go:generate gomodifytags --file $GOFILE --struct StatusProperty -add-tags json,mapstructure -w -transform snakecase
+type StatusProperty struct {
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Status *StatusOption       `json:"status" mapstructure:"status" `
+}
+
+// StatusOption object represents Notion status option.
+//go:generate gomodifytags --file $GOFILE --struct StatusOption -add-tags json,mapstructure -w -transform snakecase
+type StatusOption struct {
+	Name  string `json:"name" mapstructure:"name" `
+	ID    string `json:"id,omitempty" mapstructure:"id" `
+	Color Color  `json:"color,omitempty" mapstructure:"color" `
+}
+
+// GetType returns the type of the property.
+func (p *StatusProperty) GetType() object.PropertyType {
+	return object.StatusPropertyType
+}
+
+func (p *StatusProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *StatusProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.StatusPropertyType, p.ID, p.Status)
+}
+
+// UniqueIDProperty object represents Notion unique ID Property.
+//go:generate gomodifytags --file $GOFILE --struct UniqueIDProperty -add-tags json,mapstructure -w -transform snakecase
+type UniqueIDProperty struct {
+	Type     object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID       string              `json:"id,omitempty" mapstructure:"id" `
+	UniqueID UniqueID            `json:"unique_id" mapstructure:"unique_id" `
+}
+
+// UniqueID represents the ID Notion numbers the pages of a database with.
+type UniqueID struct {
+	Prefix *string `json:"prefix" mapstructure:"prefix" `
+	Number int     `json:"number" mapstructure:"number" `
+}
+
+// String returns the ID as Notion displays it, such as "TASK-12".
+func (id UniqueID) String() string {
+	if id.Prefix == nil || *id.Prefix == "" {
+		return fmt.Sprint(id.Number)
+	}
+
+	return fmt.Sprintf("%s-%d", *id.Prefix, id.Number)
+}
+
+// GetType returns the type of the property.
+func (p *UniqueIDProperty) GetType() object.PropertyType {
+	return object.UniqueIDPropertyType
+}
+
+func (p *UniqueIDProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *UniqueIDProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.UniqueIDPropertyType, p.ID, p.UniqueID)
+}
+
+// VerificationState is the state of a verified page.
+type VerificationState string
+
+const (
+	VerifiedVerificationState   VerificationState = "verified"
+	UnverifiedVerificationState VerificationState = "unverified"
+	ExpiredVerificationState    VerificationState = "expired"
+)
+
+// VerificationProperty object represents Notion verification Property of wiki pages.
+//go:generate gomodifytags --file $GOFILE --struct VerificationProperty -add-tags json,mapstructure -w -transform snakecase
+type VerificationProperty struct {
+	Type         object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID           string              `json:"id,omitempty" mapstructure:"id" `
+	Verification *Verification       `json:"verification" mapstructure:"verification" `
+}
+
+// Verification represents who verified the page and until when.
+type Verification struct {
+	State      VerificationState `json:"state" mapstructure:"state" `
+	VerifiedBy *User             `json:"verified_by" mapstructure:"verified_by" `
+	Date       *Date             `json:"date" mapstructure:"date" `
+}
+
+// GetType returns the type of the property.
+func (p *VerificationProperty) GetType() object.PropertyType {
+	return object.VerificationPropertyType
+}
+
+func (p *VerificationProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *VerificationProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.VerificationPropertyType, p.ID, p.Verification)
+}
+
+// ButtonProperty object represents Notion button Property, which has no value.
+//go:generate gomodifytags --file $GOFILE --struct ButtonProperty -add-tags json,mapstructure -w -transform snakecase
+type ButtonProperty struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type" `
+	ID   string              `json:"id,omitempty" mapstructure:"id" `
+}
+
+// GetType returns the type of the property.
+func (p *ButtonProperty) GetType() object.PropertyType {
+	return object.ButtonPropertyType
+}
+
+func (p *ButtonProperty) isPropertyValue() {}
+
+// MarshalJSON encodes the value keyed by its type.
+func (p *ButtonProperty) MarshalJSON() ([]byte, error) {
+	return marshalPropertyValue(object.ButtonPropertyType, p.ID, EmptyConfig{})
+}
+
+func convProperties(input map[string]interface{}) (map[string]PropertyValue, error) {
+	properties := map[string]PropertyValue{}
 	for k, v := range input {
-		var p Property
 		switch obj := v.(type) {
 		case map[string]interface{}:
-			switch object.PropertyType(obj["type"].(string)) {
-			case object.TextPropertyType:
-				p = &TextProperty{}
-			case object.TitlePropertyType:
-				switch v.(map[string]interface{})["title"].(type) {
-				case map[string]interface{}:
-					p = &DatabaseTitleProperty{}
-				default:
-					p = &PageTitleProperty{}
-				}
-
-			case object.NumberPropertyType:
-				p = &NumberProperty{}
-			case object.SelectPropertyType:
-				p = &SelectProperty{}
-			case object.MultiSelectPropertyType:
-				p = &MultiSelectProperty{}
-			case object.DatePropertyType:
-				p = &DateProperty{}
-			case object.PeoplePropertyType:
-				p = &PersonProperty{}
-			case object.FilesPropertyType:
-				p = &FilesProperty{}
-			case object.CheckboxPropertyType:
-				p = &CheckboxProperty{}
-			case object.URLPropertyType:
-				p = &URLProperty{}
-			case object.EmailPropertyType:
-				p = &EmailProperty{}
-			case object.PhoneNumberPropertyType:
-				p = &PhoneNumberProperty{}
-			case object.FormulaPropertyType:
-				p = &FormulaProperty{}
-			case object.RelationPropertyType:
-				p = &RelationProperty{}
-			case object.RollupPropertyType:
-				p = &RollupProperty{}
-			case object.CreatedTimePropertyType:
-				p = &CreatedTimeProperty{}
-			case object.CreatedByPropertyType:
-				p = &CreatedByProperty{}
-			case object.LastEditedTimePropertyType:
-				p = &LastEditedTimeProperty{}
-			case object.LastEditedByPropertyType:
-				p = &LastEditedByProperty{}
-			default:
-				return nil, fmt.Errorf("%v type is not suppported propert type", obj["type"])
-			}
-
-			if err := mapstructure.Decode(v, &p); err != nil {
+			p, err := convProperty(obj)
+			if err != nil {
 				return nil, err
 			}
 
@@ -379,3 +675,169 @@ func convProperties(input map[string]interface{}) (map[string]Property, error) {
 
 	return properties, nil
 }
+
+func convProperty(obj map[string]interface{}) (PropertyValue, error) {
+	var p PropertyValue
+	propertyType, _ := obj["type"].(string)
+	switch object.PropertyType(propertyType) {
+	case object.TextPropertyType:
+		p = &TextProperty{}
+	case object.TitlePropertyType:
+		p = &PageTitleProperty{}
+	case object.NumberPropertyType:
+		p = &NumberProperty{}
+	case object.SelectPropertyType:
+		p = &SelectProperty{}
+	case object.MultiSelectPropertyType:
+		p = &MultiSelectProperty{}
+	case object.DatePropertyType:
+		p = &DateProperty{}
+	case object.PeoplePropertyType:
+		p = &PersonProperty{}
+	case object.FilesPropertyType:
+		p = &FilesProperty{}
+	case object.CheckboxPropertyType:
+		p = &CheckboxProperty{}
+	case object.URLPropertyType:
+		p = &URLProperty{}
+	case object.EmailPropertyType:
+		p = &EmailProperty{}
+	case object.PhoneNumberPropertyType:
+		p = &PhoneNumberProperty{}
+	case object.FormulaPropertyType:
+		p = &FormulaProperty{}
+	case object.RelationPropertyType:
+		p = &RelationProperty{}
+	case object.RollupPropertyType:
+		p = &RollupProperty{}
+	case object.CreatedTimePropertyType:
+		p = &CreatedTimeProperty{}
+	case object.CreatedByPropertyType:
+		p = &CreatedByProperty{}
+	case object.LastEditedTimePropertyType:
+		p = &LastEditedTimeProperty{}
+	case object.LastEditedByPropertyType:
+		p = &LastEditedByProperty{}
+	case object.StatusPropertyType:
+		p = &StatusProperty{}
+	case object.UniqueIDPropertyType:
+		p = &UniqueIDProperty{}
+	case object.VerificationPropertyType:
+		p = &VerificationProperty{}
+	case object.ButtonPropertyType:
+		p = &ButtonProperty{}
+	default:
+		return newUnknownProperty(obj)
+	}
+
+	if err := decode(obj, p); err != nil {
+		return nil, err
+	}
+
+	if rp, ok := p.(*RollupProperty); ok && rp.Rollup != nil {
+		content, _ := obj["rollup"].(map[string]interface{})
+		array, err := convRollupArray(content["array"])
+		if err != nil {
+			return nil, err
+		}
+		rp.Rollup.Array = array
+	}
+
+	return p, nil
+}
+
+// decode decodes the response object into v, parsing timestamps into time.Time
+// and decoding the types with their own JSON decoding, such as Date, from JSON.
+func decode(input interface{}, v interface{}) error {
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeHookFunc(time.RFC3339),
+			jsonUnmarshalerHookFunc,
+		),
+		Result: v,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.Decode(input)
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// jsonUnmarshalerHookFunc decodes the data into the types implementing json.Unmarshaler through JSON.
+func jsonUnmarshalerHookFunc(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if from == to || !reflect.PtrTo(to).Implements(jsonUnmarshalerType) {
+		return data, nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.New(to)
+	if err := json.Unmarshal(b, v.Interface()); err != nil {
+		return nil, err
+	}
+
+	return v.Elem().Interface(), nil
+}
+
+// marshalPropertyValue encodes the value keyed by the property type, which is the shape
+// Notion expects when writing page properties. The type is always set from the Go type,
+// so that values built without one are still written correctly.
+func marshalPropertyValue(propertyType object.PropertyType, id string, value interface{}) ([]byte, error) {
+	v := map[string]interface{}{
+		"type":               propertyType,
+		string(propertyType): value,
+	}
+	if id != "" {
+		v["id"] = id
+	}
+
+	return json.Marshal(v)
+}
+
+// emptyIfNil returns an empty slice for nil, as Notion rejects null for list values.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+
+	return s
+}
+
+// nullString returns nil for an empty string, as Notion clears a property with null
+// and rejects empty strings for some types such as url and email.
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// convRollupArray converts the values of an array rollup, which have no property ID.
+func convRollupArray(v interface{}) ([]PropertyValue, error) {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	array := []PropertyValue{}
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		p, err := convProperty(obj)
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, p)
+	}
+
+	return array, nil
+}
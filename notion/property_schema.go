@@ -0,0 +1,655 @@
+package notion
+
+import (
+	"fmt"
+
+	"github.com/ketion-so/go-notion/notion/object"
+	"github.com/mitchellh/mapstructure"
+)
+
+// PropertySchema represents the configuration of a database property.
+type PropertySchema interface {
+	GetType() object.PropertyType
+	GetID() string
+	isPropertySchema()
+}
+
+// EmptyConfig is the configuration of the property types which have nothing to configure.
+type EmptyConfig struct{}
+
+// TitlePropertySchema object represents Notion title property configuration.
+//go:generate gomodifytags --file $GOFILE --struct TitlePropertySchema -add-tags json,mapstructure -w -transform snakecase
+type TitlePropertySchema struct {
+	Type  object.PropertyType `json:"type" mapstructure:"type" `
+	ID    string              `json:"id,omitempty" mapstructure:"id" `
+	Name  string              `json:"name,omitempty" mapstructure:"name" `
+	Title EmptyConfig         `json:"title" mapstructure:"title" `
+}
+
+// GetType returns the type of the property.
+func (p *TitlePropertySchema) GetType() object.PropertyType {
+	return object.TitlePropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *TitlePropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *TitlePropertySchema) isPropertySchema() {}
+
+// TextPropertySchema object represents Notion rich text property configuration.
+//go:generate gomodifytags --file $GOFILE --struct TextPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type TextPropertySchema struct {
+	Type object.PropertyType `json:"type" mapstructure:"type" `
+	ID   string              `json:"id,omitempty" mapstructure:"id" `
+	Name string              `json:"name,omitempty" mapstructure:"name" `
+	Text EmptyConfig         `json:"text" mapstructure:"text" `
+}
+
+// GetType returns the type of the property.
+func (p *TextPropertySchema) GetType() object.PropertyType {
+	return object.TextPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *TextPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *TextPropertySchema) isPropertySchema() {}
+
+// NumberPropertySchema object represents Notion number property configuration.
+//go:generate gomodifytags --file $GOFILE --struct NumberPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type NumberPropertySchema struct {
+	Type   object.PropertyType `json:"type" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Name   string              `json:"name,omitempty" mapstructure:"name" `
+	Number NumberConfig        `json:"number" mapstructure:"number" `
+}
+
+// NumberConfig object represents how numbers are displayed.
+type NumberConfig struct {
+	Format NumberFormat `json:"format,omitempty" mapstructure:"format" `
+}
+
+// GetType returns the type of the property.
+func (p *NumberPropertySchema) GetType() object.PropertyType {
+	return object.NumberPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *NumberPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *NumberPropertySchema) isPropertySchema() {}
+
+// SelectPropertySchema object represents Notion select property configuration.
+//go:generate gomodifytags --file $GOFILE --struct SelectPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type SelectPropertySchema struct {
+	Type   object.PropertyType `json:"type" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Name   string              `json:"name,omitempty" mapstructure:"name" `
+	Select SelectConfig        `json:"select" mapstructure:"select" `
+}
+
+// SelectConfig object represents the options of a select property.
+type SelectConfig struct {
+	Options []SelectOption `json:"options" mapstructure:"options" `
+}
+
+// GetType returns the type of the property.
+func (p *SelectPropertySchema) GetType() object.PropertyType {
+	return object.SelectPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *SelectPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *SelectPropertySchema) isPropertySchema() {}
+
+// MultiSelectPropertySchema object represents Notion multi select property configuration.
+//go:generate gomodifytags --file $GOFILE --struct MultiSelectPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type MultiSelectPropertySchema struct {
+	Type        object.PropertyType `json:"type" mapstructure:"type" `
+	ID          string              `json:"id,omitempty" mapstructure:"id" `
+	Name        string              `json:"name,omitempty" mapstructure:"name" `
+	MultiSelect MultiSelectConfig   `json:"multi_select" mapstructure:"multi_select" `
+}
+
+// MultiSelectConfig object represents the options of a multi select property.
+type MultiSelectConfig struct {
+	Options []MultiSelectOption `json:"options" mapstructure:"options" `
+}
+
+// GetType returns the type of the property.
+func (p *MultiSelectPropertySchema) GetType() object.PropertyType {
+	return object.MultiSelectPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *MultiSelectPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *MultiSelectPropertySchema) isPropertySchema() {}
+
+// DatePropertySchema object represents Notion date property configuration.
+//go:generate gomodifytags --file $GOFILE --struct DatePropertySchema -add-tags json,mapstructure -w -transform snakecase
+type DatePropertySchema struct {
+	Type object.PropertyType `json:"type" mapstructure:"type" `
+	ID   string              `json:"id,omitempty" mapstructure:"id" `
+	Name string              `json:"name,omitempty" mapstructure:"name" `
+	Date EmptyConfig         `json:"date" mapstructure:"date" `
+}
+
+// GetType returns the type of the property.
+func (p *DatePropertySchema) GetType() object.PropertyType {
+	return object.DatePropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *DatePropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *DatePropertySchema) isPropertySchema() {}
+
+// PeoplePropertySchema object represents Notion people property configuration.
+//go:generate gomodifytags --file $GOFILE --struct PeoplePropertySchema -add-tags json,mapstructure -w -transform snakecase
+type PeoplePropertySchema struct {
+	Type   object.PropertyType `json:"type" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Name   string              `json:"name,omitempty" mapstructure:"name" `
+	People EmptyConfig         `json:"people" mapstructure:"people" `
+}
+
+// GetType returns the type of the property.
+func (p *PeoplePropertySchema) GetType() object.PropertyType {
+	return object.PeoplePropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *PeoplePropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *PeoplePropertySchema) isPropertySchema() {}
+
+// FilesPropertySchema object represents Notion files property configuration.
+//go:generate gomodifytags --file $GOFILE --struct FilesPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type FilesPropertySchema struct {
+	Type  object.PropertyType `json:"type" mapstructure:"type" `
+	ID    string              `json:"id,omitempty" mapstructure:"id" `
+	Name  string              `json:"name,omitempty" mapstructure:"name" `
+	Files EmptyConfig         `json:"files" mapstructure:"files" `
+}
+
+// GetType returns the type of the property.
+func (p *FilesPropertySchema) GetType() object.PropertyType {
+	return object.FilesPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *FilesPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *FilesPropertySchema) isPropertySchema() {}
+
+// CheckboxPropertySchema object represents Notion checkbox property configuration.
+//go:generate gomodifytags --file $GOFILE --struct CheckboxPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type CheckboxPropertySchema struct {
+	Type     object.PropertyType `json:"type" mapstructure:"type" `
+	ID       string              `json:"id,omitempty" mapstructure:"id" `
+	Name     string              `json:"name,omitempty" mapstructure:"name" `
+	Checkbox EmptyConfig         `json:"checkbox" mapstructure:"checkbox" `
+}
+
+// GetType returns the type of the property.
+func (p *CheckboxPropertySchema) GetType() object.PropertyType {
+	return object.CheckboxPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *CheckboxPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *CheckboxPropertySchema) isPropertySchema() {}
+
+// URLPropertySchema object represents Notion url property configuration.
+//go:generate gomodifytags --file $GOFILE --struct URLPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type URLPropertySchema struct {
+	Type object.PropertyType `json:"type" mapstructure:"type" `
+	ID   string              `json:"id,omitempty" mapstructure:"id" `
+	Name string              `json:"name,omitempty" mapstructure:"name" `
+	URL  EmptyConfig         `json:"url" mapstructure:"url" `
+}
+
+// GetType returns the type of the property.
+func (p *URLPropertySchema) GetType() object.PropertyType {
+	return object.URLPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *URLPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *URLPropertySchema) isPropertySchema() {}
+
+// EmailPropertySchema object represents Notion email property configuration.
+//go:generate gomodifytags --file $GOFILE --struct EmailPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type EmailPropertySchema struct {
+	Type  object.PropertyType `json:"type" mapstructure:"type" `
+	ID    string              `json:"id,omitempty" mapstructure:"id" `
+	Name  string              `json:"name,omitempty" mapstructure:"name" `
+	Email EmptyConfig         `json:"email" mapstructure:"email" `
+}
+
+// GetType returns the type of the property.
+func (p *EmailPropertySchema) GetType() object.PropertyType {
+	return object.EmailPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *EmailPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *EmailPropertySchema) isPropertySchema() {}
+
+// PhoneNumberPropertySchema object represents Notion phone number property configuration.
+//go:generate gomodifytags --file $GOFILE --struct PhoneNumberPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type PhoneNumberPropertySchema struct {
+	Type        object.PropertyType `json:"type" mapstructure:"type" `
+	ID          string              `json:"id,omitempty" mapstructure:"id" `
+	Name        string              `json:"name,omitempty" mapstructure:"name" `
+	PhoneNumber EmptyConfig         `json:"phone_number" mapstructure:"phone_number" `
+}
+
+// GetType returns the type of the property.
+func (p *PhoneNumberPropertySchema) GetType() object.PropertyType {
+	return object.PhoneNumberPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *PhoneNumberPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *PhoneNumberPropertySchema) isPropertySchema() {}
+
+// FormulaPropertySchema object represents Notion formula property configuration.
+//go:generate gomodifytags --file $GOFILE --struct FormulaPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type FormulaPropertySchema struct {
+	Type    object.PropertyType `json:"type" mapstructure:"type" `
+	ID      string              `json:"id,omitempty" mapstructure:"id" `
+	Name    string              `json:"name,omitempty" mapstructure:"name" `
+	Formula FormulaConfig       `json:"formula" mapstructure:"formula" `
+}
+
+// FormulaConfig object represents the expression of a formula property.
+type FormulaConfig struct {
+	Expression string `json:"expression" mapstructure:"expression" `
+}
+
+// GetType returns the type of the property.
+func (p *FormulaPropertySchema) GetType() object.PropertyType {
+	return object.FormulaPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *FormulaPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *FormulaPropertySchema) isPropertySchema() {}
+
+// RelationPropertySchema object represents Notion relation property configuration.
+//go:generate gomodifytags --file $GOFILE --struct RelationPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type RelationPropertySchema struct {
+	Type     object.PropertyType `json:"type" mapstructure:"type" `
+	ID       string              `json:"id,omitempty" mapstructure:"id" `
+	Name     string              `json:"name,omitempty" mapstructure:"name" `
+	Relation RelationConfig      `json:"relation" mapstructure:"relation" `
+}
+
+// RelationConfig object represents the related database of a relation property.
+type RelationConfig struct {
+	DatabaseID         string `json:"database_id" mapstructure:"database_id" `
+	SyncedPropertyName string `json:"synced_property_name,omitempty" mapstructure:"synced_property_name" `
+	SyncedPropertyID   string `json:"synced_property_id,omitempty" mapstructure:"synced_property_id" `
+}
+
+// GetType returns the type of the property.
+func (p *RelationPropertySchema) GetType() object.PropertyType {
+	return object.RelationPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *RelationPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *RelationPropertySchema) isPropertySchema() {}
+
+// RollupPropertySchema object represents Notion rollup property configuration.
+//go:generate gomodifytags --file $GOFILE --struct RollupPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type RollupPropertySchema struct {
+	Type   object.PropertyType `json:"type" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Name   string              `json:"name,omitempty" mapstructure:"name" `
+	Rollup RollupConfig        `json:"rollup" mapstructure:"rollup" `
+}
+
+// RollupConfig object represents the relation, property and function a rollup is computed from.
+type RollupConfig struct {
+	RelationPropertyName string `json:"relation_property_name,omitempty" mapstructure:"relation_property_name" `
+	RelationPropertyID   string `json:"relation_property_id,omitempty" mapstructure:"relation_property_id" `
+	RollupPropertyName   string `json:"rollup_property_name,omitempty" mapstructure:"rollup_property_name" `
+	RollupPropertyID     string `json:"rollup_property_id,omitempty" mapstructure:"rollup_property_id" `
+	Function             string `json:"function" mapstructure:"function" `
+}
+
+// GetType returns the type of the property.
+func (p *RollupPropertySchema) GetType() object.PropertyType {
+	return object.RollupPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *RollupPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *RollupPropertySchema) isPropertySchema() {}
+
+// CreatedTimePropertySchema object represents Notion created time property configuration.
+//go:generate gomodifytags --file $GOFILE --struct CreatedTimePropertySchema -add-tags json,mapstructure -w -transform snakecase
+type CreatedTimePropertySchema struct {
+	Type        object.PropertyType `json:"type" mapstructure:"type" `
+	ID          string              `json:"id,omitempty" mapstructure:"id" `
+	Name        string              `json:"name,omitempty" mapstructure:"name" `
+	CreatedTime EmptyConfig         `json:"created_time" mapstructure:"created_time" `
+}
+
+// GetType returns the type of the property.
+func (p *CreatedTimePropertySchema) GetType() object.PropertyType {
+	return object.CreatedTimePropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *CreatedTimePropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *CreatedTimePropertySchema) isPropertySchema() {}
+
+// CreatedByPropertySchema object represents Notion created by property configuration.
+//go:generate gomodifytags --file $GOFILE --struct CreatedByPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type CreatedByPropertySchema struct {
+	Type      object.PropertyType `json:"type" mapstructure:"type" `
+	ID        string              `json:"id,omitempty" mapstructure:"id" `
+	Name      string              `json:"name,omitempty" mapstructure:"name" `
+	CreatedBy EmptyConfig         `json:"created_by" mapstructure:"created_by" `
+}
+
+// GetType returns the type of the property.
+func (p *CreatedByPropertySchema) GetType() object.PropertyType {
+	return object.CreatedByPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *CreatedByPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *CreatedByPropertySchema) isPropertySchema() {}
+
+// LastEditedTimePropertySchema object represents Notion last edited time property configuration.
+//go:generate gomodifytags --file $GOFILE --struct LastEditedTimePropertySchema -add-tags json,mapstructure -w -transform snakecase
+type LastEditedTimePropertySchema struct {
+	Type           object.PropertyType `json:"type" mapstructure:"type" `
+	ID             string              `json:"id,omitempty" mapstructure:"id" `
+	Name           string              `json:"name,omitempty" mapstructure:"name" `
+	LastEditedTime EmptyConfig         `json:"last_edited_time" mapstructure:"last_edited_time" `
+}
+
+// GetType returns the type of the property.
+func (p *LastEditedTimePropertySchema) GetType() object.PropertyType {
+	return object.LastEditedTimePropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *LastEditedTimePropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *LastEditedTimePropertySchema) isPropertySchema() {}
+
+// LastEditedByPropertySchema object represents Notion last edited by property configuration.
+//go:generate gomodifytags --file $GOFILE --struct LastEditedByPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type LastEditedByPropertySchema struct {
+	Type         object.PropertyType `json:"type" mapstructure:"type" `
+	ID           string              `json:"id,omitempty" mapstructure:"id" `
+	Name         string              `json:"name,omitempty" mapstructure:"name" `
+	LastEditedBy EmptyConfig         `json:"last_edited_by" mapstructure:"last_edited_by" `
+}
+
+// GetType returns the type of the property.
+func (p *LastEditedByPropertySchema) GetType() object.PropertyType {
+	return object.LastEditedByPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *LastEditedByPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *LastEditedByPropertySchema) isPropertySchema() {}
+
+// StatusPropertySchema object represents Notion status property configuration.
+//go:generate gomodifytags --file $GOFILE --struct StatusPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type StatusPropertySchema struct {
+	Type   object.PropertyType `json:"type" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Name   string              `json:"name,omitempty" mapstructure:"name" `
+	Status StatusConfig        `json:"status" mapstructure:"status" `
+}
+
+// StatusConfig object represents the options of a status property and the groups they belong to.
+type StatusConfig struct {
+	Options []StatusOption `json:"options" mapstructure:"options" `
+	Groups  []StatusGroup  `json:"groups" mapstructure:"groups" `
+}
+
+// StatusGroup object represents a group of status options, such as "To-do", "In progress" and "Complete".
+//go:generate gomodifytags --file $GOFILE --struct StatusGroup -add-tags json,mapstructure -w -transform snakecase
+type StatusGroup struct {
+	Name      string   `json:"name" mapstructure:"name" `
+	ID        string   `json:"id,omitempty" mapstructure:"id" `
+	Color     Color    `json:"color,omitempty" mapstructure:"color" `
+	OptionIDs []string `json:"option_ids" mapstructure:"option_ids" `
+}
+
+// Group returns the group of the option with the name, or nil when there is none.
+func (c *StatusConfig) Group(option string) *StatusGroup {
+	for _, o := range c.Options {
+		if o.Name != option {
+			continue
+		}
+
+		for i, g := range c.Groups {
+			for _, id := range g.OptionIDs {
+				if id == o.ID {
+					return &c.Groups[i]
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetType returns the type of the property.
+func (p *StatusPropertySchema) GetType() object.PropertyType {
+	return object.StatusPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *StatusPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *StatusPropertySchema) isPropertySchema() {}
+
+// UniqueIDPropertySchema object represents Notion unique ID property configuration.
+//go:generate gomodifytags --file $GOFILE --struct UniqueIDPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type UniqueIDPropertySchema struct {
+	Type     object.PropertyType `json:"type" mapstructure:"type" `
+	ID       string              `json:"id,omitempty" mapstructure:"id" `
+	Name     string              `json:"name,omitempty" mapstructure:"name" `
+	UniqueID UniqueIDConfig      `json:"unique_id" mapstructure:"unique_id" `
+}
+
+// UniqueIDConfig object represents the prefix of the unique IDs.
+type UniqueIDConfig struct {
+	Prefix *string `json:"prefix" mapstructure:"prefix" `
+}
+
+// GetType returns the type of the property.
+func (p *UniqueIDPropertySchema) GetType() object.PropertyType {
+	return object.UniqueIDPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *UniqueIDPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *UniqueIDPropertySchema) isPropertySchema() {}
+
+// VerificationPropertySchema object represents Notion verification property configuration.
+//go:generate gomodifytags --file $GOFILE --struct VerificationPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type VerificationPropertySchema struct {
+	Type         object.PropertyType `json:"type" mapstructure:"type" `
+	ID           string              `json:"id,omitempty" mapstructure:"id" `
+	Name         string              `json:"name,omitempty" mapstructure:"name" `
+	Verification EmptyConfig         `json:"verification" mapstructure:"verification" `
+}
+
+// GetType returns the type of the property.
+func (p *VerificationPropertySchema) GetType() object.PropertyType {
+	return object.VerificationPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *VerificationPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *VerificationPropertySchema) isPropertySchema() {}
+
+// ButtonPropertySchema object represents Notion button property configuration.
+//go:generate gomodifytags --file $GOFILE --struct ButtonPropertySchema -add-tags json,mapstructure -w -transform snakecase
+type ButtonPropertySchema struct {
+	Type   object.PropertyType `json:"type" mapstructure:"type" `
+	ID     string              `json:"id,omitempty" mapstructure:"id" `
+	Name   string              `json:"name,omitempty" mapstructure:"name" `
+	Button EmptyConfig         `json:"button" mapstructure:"button" `
+}
+
+// GetType returns the type of the property.
+func (p *ButtonPropertySchema) GetType() object.PropertyType {
+	return object.ButtonPropertyType
+}
+
+// GetID returns the ID of the property.
+func (p *ButtonPropertySchema) GetID() string {
+	return p.ID
+}
+
+func (p *ButtonPropertySchema) isPropertySchema() {}
+
+func convPropertySchemas(input map[string]interface{}) (map[string]PropertySchema, error) {
+	properties := map[string]PropertySchema{}
+	for k, v := range input {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		p, err := convPropertySchema(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		properties[k] = p
+	}
+
+	return properties, nil
+}
+
+func convPropertySchema(obj map[string]interface{}) (PropertySchema, error) {
+	var p PropertySchema
+	switch object.PropertyType(fmt.Sprint(obj["type"])) {
+	case object.TitlePropertyType:
+		p = &TitlePropertySchema{}
+	case object.TextPropertyType:
+		p = &TextPropertySchema{}
+	case object.NumberPropertyType:
+		p = &NumberPropertySchema{}
+	case object.SelectPropertyType:
+		p = &SelectPropertySchema{}
+	case object.MultiSelectPropertyType:
+		p = &MultiSelectPropertySchema{}
+	case object.DatePropertyType:
+		p = &DatePropertySchema{}
+	case object.PeoplePropertyType:
+		p = &PeoplePropertySchema{}
+	case object.FilesPropertyType:
+		p = &FilesPropertySchema{}
+	case object.CheckboxPropertyType:
+		p = &CheckboxPropertySchema{}
+	case object.URLPropertyType:
+		p = &URLPropertySchema{}
+	case object.EmailPropertyType:
+		p = &EmailPropertySchema{}
+	case object.PhoneNumberPropertyType:
+		p = &PhoneNumberPropertySchema{}
+	case object.FormulaPropertyType:
+		p = &FormulaPropertySchema{}
+	case object.RelationPropertyType:
+		p = &RelationPropertySchema{}
+	case object.RollupPropertyType:
+		p = &RollupPropertySchema{}
+	case object.CreatedTimePropertyType:
+		p = &CreatedTimePropertySchema{}
+	case object.CreatedByPropertyType:
+		p = &CreatedByPropertySchema{}
+	case object.LastEditedTimePropertyType:
+		p = &LastEditedTimePropertySchema{}
+	case object.LastEditedByPropertyType:
+		p = &LastEditedByPropertySchema{}
+	case object.StatusPropertyType:
+		p = &StatusPropertySchema{}
+	case object.UniqueIDPropertyType:
+		p = &UniqueIDPropertySchema{}
+	case object.VerificationPropertyType:
+		p = &VerificationPropertySchema{}
+	case object.ButtonPropertyType:
+		p = &ButtonPropertySchema{}
+	default:
+		return newUnknownProperty(obj)
+	}
+
+	if err := mapstructure.Decode(obj, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,121 @@
+package notion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// roundTrip decodes the properties with conv and returns them encoded again,
+// along with the input, both as generic JSON values.
+func roundTrip[T any](t *testing.T, input string, conv func(map[string]interface{}) (map[string]T, error)) (interface{}, interface{}) {
+	t.Helper()
+
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(input), &raw); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	properties, err := conv(raw)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(properties)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got, want interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(input), &want); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	return got, want
+}
+
+func TestPropertySchema_RoundTrip(t *testing.T) {
+	input := `{
+		"Name": {"id": "title", "name": "Name", "type": "title", "title": {}},
+		"Notes": {"id": "nt", "name": "Notes", "type": "text", "text": {}},
+		"Price": {"id": "cU^N", "name": "Price", "type": "number", "number": {"format": "dollar"}},
+		"Food group": {"id": "TJmr", "name": "Food group", "type": "select", "select": {"options": [
+			{"id": "96eb622f-4b88-4283-919d-ece2fbed3841", "name": "Vegetable", "color": "green"}
+		]}},
+		"Stores": {"id": "st", "name": "Stores", "type": "multi_select", "multi_select": {"options": [
+			{"id": "d209b920-212c-4040-9d4a-bdf349dd8b2a", "name": "Duc Loi Market", "color": "blue"}
+		]}},
+		"Last ordered": {"id": "]\\R[", "name": "Last ordered", "type": "date", "date": {}},
+		"+1": {"id": "aGut", "name": "+1", "type": "people", "people": {}},
+		"Photo": {"id": "aTIT", "name": "Photo", "type": "files", "files": {}},
+		"In stock": {"id": "{xY", "name": "In stock", "type": "checkbox", "checkbox": {}},
+		"Link": {"id": "lk", "name": "Link", "type": "url", "url": {}},
+		"Email": {"id": "em", "name": "Email", "type": "email", "email": {}},
+		"Phone": {"id": "ph", "name": "Phone", "type": "phone_number", "phone_number": {}},
+		"Cost of next trip": {"id": "p:sC", "name": "Cost of next trip", "type": "formula", "formula": {"expression": "if(prop(\"In stock\"), 0, prop(\"Price\"))"}},
+		"Meals": {"id": "mxp^", "name": "Meals", "type": "relation", "relation": {"database_id": "668d797c-76fa-4934-9b05-ad288df2d136", "synced_property_name": "Ingredients", "synced_property_id": "in"}},
+		"Number of meals": {"id": "Z\\Eh", "name": "Number of meals", "type": "rollup", "rollup": {
+			"rollup_property_name": "Name", "relation_property_name": "Meals", "rollup_property_id": "title", "relation_property_id": "mxp^", "function": "count"
+		}},
+		"Created": {"id": "ct", "name": "Created", "type": "created_time", "created_time": {}},
+		"Creator": {"id": "cb", "name": "Creator", "type": "created_by", "created_by": {}},
+		"Edited": {"id": "et", "name": "Edited", "type": "last_edited_time", "last_edited_time": {}},
+		"Editor": {"id": "eb", "name": "Editor", "type": "last_edited_by", "last_edited_by": {}},
+		"Stage": {"id": "sg", "name": "Stage", "type": "status", "status": {
+			"options": [
+				{"id": "a1", "name": "Not started", "color": "default"},
+				{"id": "b4f1c2d3", "name": "In review", "color": "blue"},
+				{"id": "c9", "name": "Done", "color": "green"}
+			],
+			"groups": [
+				{"id": "g1", "name": "To-do", "color": "gray", "option_ids": ["a1"]},
+				{"id": "g2", "name": "In progress", "color": "blue", "option_ids": ["b4f1c2d3"]},
+				{"id": "g3", "name": "Complete", "color": "green", "option_ids": ["c9"]}
+			]
+		}},
+		"Task ID": {"id": "tid", "name": "Task ID", "type": "unique_id", "unique_id": {"prefix": "TASK"}},
+		"Verified": {"id": "vf", "name": "Verified", "type": "verification", "verification": {}},
+		"Reorder": {"id": "ro", "name": "Reorder", "type": "button", "button": {}}
+	}`
+
+	got, want := roundTrip(t, input, convPropertySchemas)
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestStatusConfig_Group(t *testing.T) {
+	config := &StatusConfig{
+		Options: []StatusOption{{ID: "a1", Name: "Not started"}, {ID: "c9", Name: "Done"}},
+		Groups: []StatusGroup{
+			{ID: "g1", Name: "To-do", OptionIDs: []string{"a1"}},
+			{ID: "g3", Name: "Complete", OptionIDs: []string{"c9"}},
+		},
+	}
+
+	tcs := map[string]struct {
+		option string
+		want   string
+	}{
+		"to-do":    {"Not started", "To-do"},
+		"complete": {"Done", "Complete"},
+		"missing":  {"Archived", ""},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := ""
+			if g := config.Group(tc.option); g != nil {
+				got = g.Name
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
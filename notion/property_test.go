@@ -0,0 +1,164 @@
+package notion
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestPropertyValue_RoundTrip(t *testing.T) {
+	input := `{
+		"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Tuscan Kale"}, "plain_text": "Tuscan Kale"}]},
+		"Notes": {"id": "nt", "type": "text", "text": []},
+		"Price": {"id": "cU^N", "type": "number", "number": 2.5},
+		"Food group": {"id": "TJmr", "type": "select", "select": {"id": "96eb622f-4b88-4283-919d-ece2fbed3841", "name": "Vegetable", "color": "green"}},
+		"Empty group": {"id": "eg", "type": "select", "select": null},
+		"Stores": {"id": "st", "type": "multi_select", "multi_select": [{"id": "d209b920-212c-4040-9d4a-bdf349dd8b2a", "name": "Duc Loi Market", "color": "blue"}]},
+		"Last ordered": {"id": "]\\R[", "type": "date", "date": {"start": "2021-05-12", "end": null, "time_zone": null}},
+		"Delivery": {"id": "dl", "type": "date", "date": {"start": "2021-05-11T11:00:00.000-04:00", "end": "2021-05-11T12:30:00.000-04:00", "time_zone": null}},
+		"Pickup": {"id": "pu", "type": "date", "date": {"start": "2021-05-13T09:00:00.000", "end": null, "time_zone": "Asia/Tokyo"}},
+		"Never ordered": {"id": "no", "type": "date", "date": null},
+		"+1": {"id": "aGut", "type": "people", "people": [
+			{"object": "user", "id": "d40e767c-d7af-4b18-a86d-55c61f1e39a4", "type": "person", "name": "Avocado Lovelace", "person": {"email": "avo@example.org"}}
+		]},
+		"Photo": {"id": "aTIT", "type": "files", "files": [
+			{"name": "kale.png", "type": "file", "file": {"url": "https://s3.us-west-2.amazonaws.com/kale.png", "expiry_time": "2021-05-18T10:00:00.123Z"}},
+			{"name": "Kale", "type": "external", "external": {"url": "https://example.org/kale.png"}}
+		]},
+		"No photo": {"id": "np", "type": "files", "files": []},
+		"In stock": {"id": "{xY", "type": "checkbox", "checkbox": false},
+		"Link": {"id": "lk", "type": "url", "url": null},
+		"Email": {"id": "em", "type": "email", "email": "kale@example.org"},
+		"Phone": {"id": "ph", "type": "phone_number", "phone_number": null},
+		"Meals": {"id": "mxp^", "type": "relation", "relation": [{"id": "3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"}]},
+		"Created": {"id": "ct", "type": "created_time", "created_time": "2020-03-17T19:10:04.968Z"},
+		"Edited": {"id": "et", "type": "last_edited_time", "last_edited_time": "2020-03-17T21:49:37.913Z"},
+		"Creator": {"id": "cb", "type": "created_by", "created_by": {"object": "user", "id": "9a3b5ae0-c6e6-482d-b0e1-ed315ee6dc57"}},
+		"Cost": {"id": "p:sC", "type": "formula", "formula": {"type": "number", "number": 2.5}},
+		"Label": {"id": "lb", "type": "formula", "formula": {"type": "string", "string": null}},
+		"Meal count": {"id": "Z\\Eh", "type": "rollup", "rollup": {"type": "number", "number": 3, "function": "count"}},
+		"Last meal": {"id": "lm", "type": "rollup", "rollup": {"type": "date", "date": null, "function": "latest_date"}},
+		"Meal names": {"id": "mn", "type": "rollup", "rollup": {"type": "array", "function": "show_original", "array": [
+			{"type": "checkbox", "checkbox": true}
+		]}},
+		"Stage": {"id": "sg", "type": "status", "status": {"id": "b4f1c2d3", "name": "In review", "color": "blue"}},
+		"No stage": {"id": "ns", "type": "status", "status": null},
+		"Task ID": {"id": "tid", "type": "unique_id", "unique_id": {"prefix": "TASK", "number": 42}},
+		"Number": {"id": "nb", "type": "unique_id", "unique_id": {"prefix": null, "number": 7}},
+		"Verified": {"id": "vf", "type": "verification", "verification": {
+			"state": "verified",
+			"verified_by": {"object": "user", "id": "d40e767c-d7af-4b18-a86d-55c61f1e39a4"},
+			"date": {"start": "2021-05-12T00:00:00.000Z", "end": "2021-08-10T00:00:00.000Z", "time_zone": null}
+		}},
+		"Reorder": {"id": "ro", "type": "button", "button": {}}
+	}`
+
+	got, want := roundTrip(t, input, convProperties)
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// assertGolden compares the JSON encoding of v with testdata/name, ignoring formatting.
+func assertGolden(t *testing.T, name string, v interface{}) {
+	t.Helper()
+
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got, want interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal(golden, &want); err != nil {
+		t.Fatalf("invalid golden file %s: %v", path, err)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestPropertyValue_MarshalJSON(t *testing.T) {
+	tcs := map[string]PropertyValue{
+		"title":              &PageTitleProperty{Title: []TextObject{{Text: &Text{Content: "Tuscan Kale"}}}},
+		"text":               &TextProperty{ID: "D[X|", Text: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "A dark green leafy vegetable"}}}},
+		"number":             NewNumber(2.5),
+		"select":             &SelectProperty{Select: &SelectOption{Name: "Vegetable"}},
+		"empty_select":       &SelectProperty{},
+		"multi_select":       &MultiSelectProperty{MultiSelect: []MultiSelectOption{{Name: "Duc Loi Market"}, {Name: "Rainbow Grocery"}}},
+		"empty_multi_select": &MultiSelectProperty{},
+		"date":               &DateProperty{Date: NewDate(time.Date(2021, 5, 11, 0, 0, 0, 0, time.UTC)).Until(time.Date(2021, 5, 13, 0, 0, 0, 0, time.UTC))},
+		"people":             &PersonProperty{People: []User{{Object: object.User, ID: "d40e767c-d7af-4b18-a86d-55c61f1e39a4"}}},
+		"files":              &FilesProperty{Files: []File{NewExternalFile("Kale", "https://example.org/kale.png")}},
+		"checkbox":           &CheckboxProperty{},
+		"url":                &URLProperty{URL: "https://example.org"},
+		"empty_url":          &URLProperty{},
+		"email":              &EmailProperty{Email: "avo@example.org"},
+		"empty_phone_number": &PhoneNumberProperty{},
+		"relation":           &RelationProperty{Relation: []Relation{{ID: "3d4dcd6f-4ad6-4d58-95e0-f4b3a1b9e1f8"}}},
+		"empty_relation":     &RelationProperty{},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			assertGolden(t, filepath.Join("properties", n+".json"), tc)
+		})
+	}
+}
+
+func TestPageRequest_MarshalJSON(t *testing.T) {
+	properties := func() map[string]PropertyValue {
+		return map[string]PropertyValue{
+			"Name":        &PageTitleProperty{Title: []TextObject{{Text: &Text{Content: "Tuscan Kale"}}}},
+			"Description": &TextProperty{Text: []TextObject{{Text: &Text{Content: "A dark green leafy vegetable"}}}},
+			"Food group":  &SelectProperty{Select: &SelectOption{Name: "Vegetable"}},
+			"Price":       NewNumber(2.5),
+			"Cost":        &FormulaProperty{Type: object.FormulaPropertyType, Formula: &Formula{Type: NumberFormulaType}},
+		}
+	}
+
+	t.Run("create", func(t *testing.T) {
+		assertGolden(t, filepath.Join("requests", "create_page.json"), &CreatePageRequest{
+			Parent:     &DatabaseParent{DatabaseID: "48f8fee9-cd79-4180-bc2f-ec0398253067"},
+			Properties: properties(),
+			Children: []Block{
+				&HeadingTwoBlock{Text: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Lacinato kale"}}}},
+			},
+		})
+	})
+
+	t.Run("update", func(t *testing.T) {
+		archived := false
+		assertGolden(t, filepath.Join("requests", "update_page.json"), &UpdatePageRequest{
+			Properties: map[string]PropertyValue{
+				"In stock": &CheckboxProperty{Checkbox: true},
+				"Cost":     &FormulaProperty{Type: object.FormulaPropertyType},
+			},
+			Archived: &archived,
+		})
+	})
+}
@@ -0,0 +1,132 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RowError reports a database row which could not be decoded.
+type RowError struct {
+	PageID string
+	Err    error
+}
+
+// Error implements the error interface
+func (e *RowError) Error() string {
+	return fmt.Sprintf("page %s: %v", e.PageID, e.Err)
+}
+
+// Unwrap returns the decode error.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// RowErrors is returned by QueryAs along with the rows which were decoded
+// when some of the rows could not be decoded.
+type RowErrors []*RowError
+
+// Error implements the error interface
+func (e RowErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d rows could not be decoded: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the row errors, for errors.Is and errors.As of Go 1.20 and later.
+func (e RowErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// Is reports whether any of the row errors matches target, for Go versions
+// whose errors.Is does not use Unwrap() []error.
+func (e RowErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first row error which matches target, for Go versions
+// whose errors.As does not use Unwrap() []error.
+func (e RowErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QueryAs queries the database and decodes every page of the results into T
+// with UnmarshalPage. All the result pages are fetched by following the cursor.
+//
+// Rows which can not be decoded are reported as RowErrors, while the others are still returned.
+func QueryAs[T any](ctx context.Context, client *Client, databaseID DatabaseID, query *DatabaseQuery) ([]T, error) {
+	q := DatabaseQuery{}
+	if query != nil {
+		q = *query
+	}
+
+	rows := []T{}
+	var rowErrs RowErrors
+	for {
+		results, err := client.Databases.Query(ctx, databaseID, &q)
+		if err != nil {
+			return rows, err
+		}
+
+		for _, result := range results.Results {
+			page, ok := result.(*Page)
+			if !ok {
+				continue
+			}
+
+			var row T
+			if err := UnmarshalPage(page, &row); err != nil {
+				rowErrs = append(rowErrs, &RowError{PageID: page.ID, Err: err})
+				continue
+			}
+			rows = append(rows, row)
+		}
+
+		if !results.HasMore || results.NextCursor == "" {
+			break
+		}
+		q.StartCursor = results.NextCursor
+	}
+
+	if len(rowErrs) > 0 {
+		return rows, rowErrs
+	}
+
+	return rows, nil
+}
+
+// GetAs retrieves the page and decodes it into T with UnmarshalPage.
+func GetAs[T any](ctx context.Context, client *Client, pageID PageID) (*T, error) {
+	page, err := client.Pages.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var v T
+	if err := UnmarshalPage(page, &v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
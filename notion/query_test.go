@@ -0,0 +1,99 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type grocery struct {
+	Name    string `notion:"Name,title"`
+	InStock bool   `notion:"In stock,checkbox"`
+}
+
+func queryRowJSON(id, name string, properties string) string {
+	return fmt.Sprintf(`{
+		"object": "page",
+		"id": "%s",
+		"parent": {"type": "database_id", "database_id": "668d797c-76fa-4934-9b05-ad288df2d136"},
+		"properties": {
+			"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "%s"}, "plain_text": "%s"}]}%s
+		}
+	}`, id, name, name, properties)
+}
+
+func TestQueryAs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	databaseID := DatabaseID("668d797c-76fa-4934-9b05-ad288df2d136")
+	mux.HandleFunc(fmt.Sprintf("/%s/%s/query", databasesPath, databaseID), func(w http.ResponseWriter, r *http.Request) {
+		q := DatabaseQuery{}
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			t.Fatalf("invalid query: %v", err)
+		}
+
+		switch q.StartCursor {
+		case "":
+			fmt.Fprintf(w, `{"object": "list", "has_more": true, "next_cursor": "cursor-2", "results": [%s, %s]}`,
+				queryRowJSON("251d2b5f-268c-4de2-afe9-c71ff92ca95c", "Tuscan Kale", `, "In stock": {"id": "{>U;", "type": "checkbox", "checkbox": true}`),
+				queryRowJSON("60bdc8bd-3880-44b8-a9cd-8a145b3ffbd7", "Avocado", ""),
+			)
+		default:
+			fmt.Fprintf(w, `{"object": "list", "has_more": false, "next_cursor": null, "results": [%s]}`,
+				queryRowJSON("b55c9c91-384d-452b-81db-d1ef79372b75", "Lemon", `, "In stock": {"id": "{>U;", "type": "checkbox", "checkbox": false}`),
+			)
+		}
+	})
+
+	got, err := QueryAs[grocery](context.Background(), client, databaseID, nil)
+
+	var rowErrs RowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected RowErrors, got %v", err)
+	}
+
+	if len(rowErrs) != 1 || rowErrs[0].PageID != "60bdc8bd-3880-44b8-a9cd-8a145b3ffbd7" {
+		t.Fatalf("unexpected row errors: %v", rowErrs)
+	}
+
+	var notFound *PropertyNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected PropertyNotFoundError, got %v", rowErrs[0].Err)
+	}
+	if !errors.Is(err, rowErrs[0].Err) {
+		t.Fatalf("row error not found in %v", err)
+	}
+
+	want := []grocery{
+		{Name: "Tuscan Kale", InStock: true},
+		{Name: "Lemon", InStock: false},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestGetAs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	pageID := PageID("251d2b5f-268c-4de2-afe9-c71ff92ca95c")
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", pagesPath, pageID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, queryRowJSON(pageID.String(), "Tuscan Kale", `, "In stock": {"id": "{>U;", "type": "checkbox", "checkbox": true}`))
+	})
+
+	got, err := GetAs[grocery](context.Background(), client, pageID)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, &grocery{Name: "Tuscan Kale", InStock: true}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -60,6 +60,7 @@ type TextObject struct {
 	Annotations *Annotations `json:"annotations,omitempty" mapstructure:"annotations"`
 	Type        RichTextType `json:"type,omitempty" mapstructure:"type"`
 	Text        *Text        `json:"text,omitempty" mapstructure:"text"`
+	Mention     *Mention     `json:"mention,omitempty" mapstructure:"mention"`
 	Link        *LinkObject  `json:"link,omitempty" mapstructure:"link"`
 }
 
@@ -97,9 +98,28 @@ const (
 	UserMentionObject     MentionObjectType = "user"
 	PageMentionObject     MentionObjectType = "page"
 	DatabaseMentionObject MentionObjectType = "database"
-	DateionObject         MentionObjectType = "date"
+	DateMentionObject     MentionObjectType = "date"
+
+	// Deprecated: use DateMentionObject.
+	DateionObject = DateMentionObject
 )
 
+// Mention represents the content of a mention rich text object.
+//go:generate gomodifytags -file $GOFILE -struct Mention -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct Mention -add-tags json,mapstructure -w -transform snakecase
+type Mention struct {
+	Type     MentionObjectType `json:"type" mapstructure:"type"`
+	User     *User             `json:"user,omitempty" mapstructure:"user"`
+	Page     *ObjectReference  `json:"page,omitempty" mapstructure:"page"`
+	Database *ObjectReference  `json:"database,omitempty" mapstructure:"database"`
+	Date     *Date             `json:"date,omitempty" mapstructure:"date"`
+}
+
+// ObjectReference represents a page or database referenced by its ID.
+type ObjectReference struct {
+	ID string `json:"id" mapstructure:"id"`
+}
+
 // MentionObject object represents Notion rich text object
 //go:generate gomodifytags -file $GOFILE -struct MentionObject -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct MentionObject -add-tags json,mapstructure -w -transform snakecase
@@ -110,6 +130,7 @@ type MentionObject struct {
 	Type        RichTextType `json:"type,omitempty" mapstructure:"type"`
 	Database    *Database    `json:"database,omitempty" mapstructure:"database"`
 	User        *User        `json:"user,omitempty" mapstructure:"user"`
+	Date        *Date        `json:"date,omitempty" mapstructure:"date"`
 }
 
 // GetType returns the object type
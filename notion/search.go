@@ -52,13 +52,13 @@ const (
 	Descending Direction = "descending"
 )
 
-// Sort object represents Notion User.
+// Sort object represents Notion sort, by a property or by a timestamp.
 //go:generate gomodifytags -file $GOFILE -struct Sort -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Sort -add-tags json,mapstructure -w -transform snakecase
 type Sort struct {
 	Property  string    `json:"property,omitempty" mapstructure:"property"`
 	Direction Direction `json:"direction" mapstructure:"direction"`
-	Timestamp string    `json:"timestamp" mapstructure:"timestamp"`
+	Timestamp string    `json:"timestamp,omitempty" mapstructure:"timestamp"`
 }
 
 // FiterValue is a type for specifying what to filter
@@ -126,6 +126,7 @@ func (s *SearchService) Search(ctx context.Context, sreq *SearchRequest) (*Searc
 			objects = append(objects, page)
 		}
 	}
+	s.client.warn("", objects)
 
 	return &SearchResults{
 		HasMore:    data.HasMore,
@@ -140,9 +140,9 @@ func TestSearchService_Search(t *testing.T) {
 							},
 						},
 
-						Properties: map[string]Property{
-							"Name":      &DatabaseTitleProperty{Type: "title", ID: "title", Title: &TextObject{}},
-							"Task Type": &MultiSelectProperty{Type: "multi_select", ID: "vd@l"},
+						Properties: map[string]PropertySchema{
+							"Name":      &TitlePropertySchema{Type: "title", ID: "title"},
+							"Task Type": &MultiSelectPropertySchema{Type: "multi_select", ID: "vd@l", MultiSelect: MultiSelectConfig{Options: []MultiSelectOption{}}},
 						},
 					},
 				},
@@ -169,7 +169,7 @@ func TestSearchService_Search(t *testing.T) {
 							Type:       object.DatabaseParentType,
 							DatabaseID: "e6c6f8ff-c70e-4970-91ba-98f03e0d7fc6",
 						},
-						Properties: map[string]Property{
+						Properties: map[string]PropertyValue{
 							"Name": &PageTitleProperty{
 								ID: "title",
 								Title: []TextObject{
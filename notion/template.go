@@ -0,0 +1,327 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/ketion-so/go-notion/notion/object"
+	"gopkg.in/yaml.v3"
+)
+
+// PageTemplate is a page whose properties and blocks contain text/template placeholders,
+// such as `{{.Date}}` in a title or a rich text.
+// The properties and children are kept in the shape of the create page request.
+//
+// A string which only holds a single placeholder is replaced by the value itself,
+// so that `"number": "{{.Points}}"` becomes a number. Numbers and booleans are only
+// kept as such under `number` and `checkbox`; elsewhere, such as in the content
+// of a rich text, they are converted to strings.
+type PageTemplate struct {
+	Properties map[string]interface{} `json:"properties" yaml:"properties"`
+	Children   []interface{}          `json:"children,omitempty" yaml:"children,omitempty"`
+
+	// Funcs are the functions available to the placeholders.
+	Funcs template.FuncMap `json:"-" yaml:"-"`
+}
+
+// NewPageTemplate returns the template for the page properties and blocks.
+func NewPageTemplate(properties map[string]PropertyValue, children ...Block) (*PageTemplate, error) {
+	b, err := json.Marshal(&CreatePageRequest{Properties: properties, Children: children})
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePageTemplate(b)
+}
+
+// ParsePageTemplate parses a JSON or YAML template with `properties` and `children`.
+func ParsePageTemplate(data []byte) (*PageTemplate, error) {
+	t := &PageTemplate{}
+	if err := yaml.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+
+	// Funcs are set after parsing, so only the syntax is checked here.
+	if err := t.walk(func(_, s string) (interface{}, error) {
+		tree := parse.New("")
+		tree.Mode = parse.SkipFuncCheck
+		_, err := tree.Parse(s, "", "", map[string]*parse.Tree{})
+		return s, err
+	}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// LoadPageTemplate reads the JSON or YAML template from the file.
+func LoadPageTemplate(path string) (*PageTemplate, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePageTemplate(b)
+}
+
+var singleAction = regexp.MustCompile(`^\{\{-?\s*(.+?)\s*-?\}\}$`)
+
+var actionKeywords = []string{"if ", "range ", "with ", "define ", "template ", "block ", "end", "else", "/*"}
+
+// Execute returns the template with the placeholders replaced by data.
+// Referring to a missing key of a map is an error.
+func (t *PageTemplate) Execute(data interface{}) (*PageTemplate, error) {
+	out := &PageTemplate{Funcs: t.Funcs}
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+
+	if err := out.walk(func(key, s string) (interface{}, error) {
+		v, err := t.render(s, data)
+		if err != nil {
+			return nil, err
+		}
+		return templateValue(key, v), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (t *PageTemplate) funcs(capture func(interface{}) string) template.FuncMap {
+	funcs := template.FuncMap{
+		"capture": capture,
+	}
+	for k, v := range t.Funcs {
+		funcs[k] = v
+	}
+
+	return funcs
+}
+
+// render executes the template in s. A single placeholder returns the value as is.
+func (t *PageTemplate) render(s string, data interface{}) (interface{}, error) {
+	if !strings.Contains(s, "{{") {
+		return s, nil
+	}
+
+	if m := singleAction.FindStringSubmatch(s); m != nil && !isKeywordAction(m[1]) {
+		var value interface{}
+		capture := func(v interface{}) string {
+			value = v
+			return ""
+		}
+
+		tmpl, err := template.New("").Option("missingkey=error").Funcs(t.funcs(capture)).Parse(fmt.Sprintf("{{capture (%s)}}", m[1]))
+		if err == nil {
+			if err := tmpl.Execute(&bytes.Buffer{}, data); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}
+	}
+
+	tmpl, err := template.New("").Option("missingkey=error").Funcs(t.funcs(nil)).Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.String(), nil
+}
+
+// templateValue returns the rendered value v for the key, converting numbers and
+// booleans to strings except under the keys which hold them.
+func templateValue(key string, v interface{}) interface{} {
+	if key == "number" || key == "checkbox" || v == nil {
+		return v
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(rv.Interface())
+	}
+
+	return v
+}
+
+func isKeywordAction(action string) bool {
+	for _, k := range actionKeywords {
+		if strings.HasPrefix(action, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// walk replaces every string of the properties and children with the result of f,
+// which is given the key of the string, or of the list holding it.
+func (t *PageTemplate) walk(f func(key, s string) (interface{}, error)) error {
+	var visit func(key string, v interface{}) (interface{}, error)
+	visit = func(key string, v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case string:
+			return f(key, v)
+		case map[string]interface{}:
+			for k, e := range v {
+				r, err := visit(k, e)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				v[k] = r
+			}
+		case []interface{}:
+			for i, e := range v {
+				r, err := visit(key, e)
+				if err != nil {
+					return nil, err
+				}
+				v[i] = r
+			}
+		}
+
+		return v, nil
+	}
+
+	for k, v := range t.Properties {
+		r, err := visit(k, v)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", k, err)
+		}
+		t.Properties[k] = r
+	}
+
+	for i, v := range t.Children {
+		r, err := visit("children", v)
+		if err != nil {
+			return fmt.Errorf("children[%d]: %w", i, err)
+		}
+		t.Children[i] = r
+	}
+
+	return nil
+}
+
+// CreateFromTemplate creates a page under parent from the template executed with data.
+// The rendered template goes through PagesService.Create, so the properties are
+// validated and the read-only ones are left out like for any other page.
+//
+// API doc: https://developers.notion.com/reference/post-page
+func (s *PagesService) CreateFromTemplate(ctx context.Context, parent Parent, t *PageTemplate, data interface{}) (*Page, error) {
+	rendered, err := t.Execute(data)
+	if err != nil {
+		return nil, err
+	}
+
+	preq, err := rendered.request(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Create(ctx, preq)
+}
+
+// request decodes the properties and children of the template into a create page request.
+func (t *PageTemplate) request(parent Parent) (*CreatePageRequest, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := struct {
+		Properties map[string]map[string]interface{} `json:"properties"`
+		Children   []map[string]interface{}          `json:"children"`
+	}{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	preq := &CreatePageRequest{Parent: parent, Properties: map[string]PropertyValue{}}
+	for name, obj := range raw.Properties {
+		if _, ok := obj["type"]; !ok {
+			obj["type"] = contentKey(obj, "id")
+		}
+
+		p, err := convProperty(obj)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		preq.Properties[name] = p
+	}
+
+	for i, obj := range raw.Children {
+		b, err := templateBlock(obj)
+		if err != nil {
+			return nil, fmt.Errorf("children[%d]: %w", i, err)
+		}
+		preq.Children = append(preq.Children, b)
+	}
+
+	return preq, nil
+}
+
+// templateBlock decodes the block of a template along with its nested children.
+func templateBlock(obj map[string]interface{}) (Block, error) {
+	blockType, ok := obj["type"].(string)
+	if !ok {
+		blockType = contentKey(obj, "object", "id")
+	}
+
+	if content, ok := obj[blockType].(map[string]interface{}); ok {
+		if children, ok := content["children"].([]interface{}); ok {
+			blocks := make([]Block, 0, len(children))
+			for i, child := range children {
+				childObj, ok := child.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("children[%d]: expected a block, got %T", i, child)
+				}
+
+				b, err := templateBlock(childObj)
+				if err != nil {
+					return nil, fmt.Errorf("children[%d]: %w", i, err)
+				}
+				blocks = append(blocks, b)
+			}
+			content["children"] = blocks
+		}
+	}
+
+	return decodeBlock(obj, object.BlockType(blockType))
+}
+
+// contentKey returns the key of the type-keyed content of the object, ignoring the other keys.
+func contentKey(obj map[string]interface{}, other ...string) string {
+	for k := range obj {
+		found := false
+		for _, o := range other {
+			found = found || k == o
+		}
+		if !found {
+			return k
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,247 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const standupTemplate = `
+properties:
+  Name:
+    title:
+      - text:
+          content: "Standup {{.Date}}"
+  Points:
+    number: "{{.Points}}"
+  Owner:
+    select:
+      name: "{{upper .Owner}}"
+children:
+  - object: block
+    type: paragraph
+    paragraph:
+      text:
+        - text:
+            content: "{{range .Topics}}- {{.}}\n{{end}}"
+`
+
+func TestPageTemplate_Execute(t *testing.T) {
+	tmpl, err := ParsePageTemplate([]byte(standupTemplate))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	tmpl.Funcs = map[string]interface{}{"upper": strings.ToUpper}
+
+	tcs := map[string]struct {
+		data    interface{}
+		want    string
+		wantErr bool
+	}{
+		"ok": {
+			data: map[string]interface{}{"Date": "2021-05-18", "Points": 3, "Owner": "kate", "Topics": []string{"a", "b"}},
+			want: `{"properties":{"Name":{"title":[{"text":{"content":"Standup 2021-05-18"}}]},"Owner":{"select":{"name":"KATE"}},"Points":{"number":3}},"children":[{"object":"block","paragraph":{"text":[{"text":{"content":"- a\n- b\n"}}]},"type":"paragraph"}]}`,
+		},
+		"missing key": {
+			data:    map[string]interface{}{"Date": "2021-05-18"},
+			wantErr: true,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := tmpl.Execute(tc.data)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(string(b), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestPageTemplate_Execute_scalars(t *testing.T) {
+	tmpl, err := ParsePageTemplate([]byte(`{"properties": {
+		"Name": {"title": [{"text": {"content": "{{.Points}}"}}]},
+		"Points": {"number": "{{.Points}}"},
+		"Done": {"checkbox": "{{.Done}}"},
+		"Stage": {"select": {"name": "{{.Done}}"}}
+	}}`))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := tmpl.Execute(map[string]interface{}{"Points": 2.5, "Done": true})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `{"properties":{"Done":{"checkbox":true},"Name":{"title":[{"text":{"content":"2.5"}}]},"Points":{"number":2.5},"Stage":{"select":{"name":"true"}}}}`
+	if diff := cmp.Diff(string(b), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestParsePageTemplate_invalid(t *testing.T) {
+	if _, err := ParsePageTemplate([]byte(`{"properties": {"Name": {"title": [{"text": {"content": "{{.Date"}}]}}}`)); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestLoadPageTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "template.json")
+	if err := os.WriteFile(path, []byte(`{"properties": {"Done": {"checkbox": "{{.Done}}"}}}`), 0o600); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	tmpl, err := LoadPageTemplate(path)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := tmpl.Execute(struct{ Done bool }{Done: true})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := map[string]interface{}{"Done": map[string]interface{}{"checkbox": true}}
+	if diff := cmp.Diff(got.Properties, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestNewPageTemplate(t *testing.T) {
+	tmpl, err := NewPageTemplate(map[string]PropertyValue{
+		"Name": &PageTitleProperty{Title: []TextObject{{Type: TextRichTextType, Text: &Text{Content: "Notes {{.Date}}"}}}},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := tmpl.Execute(map[string]string{"Date": "2021-05-18"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(got.Properties)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if !strings.Contains(string(b), `"content":"Notes 2021-05-18"`) {
+		t.Fatalf("unexpected properties: %s", b)
+	}
+}
+
+func TestPagesService_CreateFromTemplate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tmpl, err := ParsePageTemplate([]byte(`{
+		"properties": {
+			"Name": {"title": [{"text": {"content": "Standup {{.Date}}"}}]},
+			"Cost": {"type": "formula", "formula": {"type": "number", "number": 1}}
+		},
+		"children": [{"object": "block", "type": "to_do", "to_do": {"text": [], "checked": true}}]
+	}`))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mux.HandleFunc(fmt.Sprintf("/%s", pagesPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("invalid body: %v", err)
+		}
+
+		want := map[string]interface{}{
+			"parent":     map[string]interface{}{"database_id": "48f8fee9-cd79-4180-bc2f-ec0398253067"},
+			"properties": map[string]interface{}{"Name": map[string]interface{}{"type": "title", "title": []interface{}{map[string]interface{}{"text": map[string]interface{}{"content": "Standup 2021-05-18"}}}}},
+			"children":   []interface{}{map[string]interface{}{"object": "block", "type": "to_do", "to_do": map[string]interface{}{"text": []interface{}{}, "checked": true}}},
+		}
+		if diff := cmp.Diff(body, want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		fmt.Fprint(w, `{"object": "page", "id": "251d2b5f-268c-4de2-afe9-c71ff92ca95c", "parent": {"type": "database_id", "database_id": "48f8fee9-cd79-4180-bc2f-ec0398253067"}, "properties": {}}`)
+	})
+
+	got, err := client.Pages.CreateFromTemplate(context.Background(), &DatabaseParent{DatabaseID: "48f8fee9-cd79-4180-bc2f-ec0398253067"}, tmpl, map[string]string{"Date": "2021-05-18"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if got.ID != "251d2b5f-268c-4de2-afe9-c71ff92ca95c" {
+		t.Fatalf("unexpected page: %v", got.ID)
+	}
+}
+
+func TestPagesService_CreateFromTemplate_nested(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tmpl, err := NewPageTemplate(
+		map[string]PropertyValue{"title": &PageTitleProperty{Title: []TextObject{{Text: &Text{Content: "Retro {{.Date}}"}}}}},
+		&ToggleBlock{
+			Text: []TextObject{{Text: &Text{Content: "Actions"}}},
+			Children: []Block{
+				&BulletedListItemBlock{
+					Text:     []TextObject{{Text: &Text{Content: "Owner: {{.Owner}}"}}},
+					Children: []Block{&ToDoBlock{Text: []TextObject{{Text: &Text{Content: "Follow up"}}}}},
+				},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mux.HandleFunc(fmt.Sprintf("/%s", pagesPath), func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Children json.RawMessage `json:"children"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("invalid body: %v", err)
+		}
+
+		want := `[{"object":"block","toggle":{"text":[{"text":{"content":"Actions"}}],"children":[{"bulleted_list_item":{"text":[{"text":{"content":"Owner: kate"}}],"children":[{"object":"block","to_do":{"text":[{"text":{"content":"Follow up"}}],"checked":false},"type":"to_do"}]},"object":"block","type":"bulleted_list_item"}]},"type":"toggle"}]`
+		if diff := cmp.Diff(string(body.Children), want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		fmt.Fprint(w, `{"object": "page", "id": "251d2b5f-268c-4de2-afe9-c71ff92ca95c", "parent": {"type": "workspace", "workspace": true}, "properties": {}}`)
+	})
+
+	if _, err := client.Pages.CreateFromTemplate(context.Background(), &PageParent{PageID: "48f8fee9-cd79-4180-bc2f-ec0398253067"}, tmpl, map[string]string{"Date": "2021-05-18", "Owner": "kate"}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+}
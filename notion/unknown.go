@@ -0,0 +1,140 @@
+package notion
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// UnknownProperty holds a page property value or database property configuration
+// of a type this package does not know yet. It is encoded back exactly as it was received.
+type UnknownProperty struct {
+	Type object.PropertyType
+	ID   string
+	Raw  json.RawMessage
+}
+
+// GetType returns the type of the property.
+func (p *UnknownProperty) GetType() object.PropertyType {
+	return p.Type
+}
+
+// GetID returns the ID of the property.
+func (p *UnknownProperty) GetID() string {
+	return p.ID
+}
+
+func (p *UnknownProperty) isPropertyValue() {}
+
+func (p *UnknownProperty) isPropertySchema() {}
+
+// MarshalJSON returns the property as it was received.
+func (p *UnknownProperty) MarshalJSON() ([]byte, error) {
+	return p.Raw, nil
+}
+
+// UnknownBlock holds a block of a type this package does not know yet,
+// including the blocks Notion itself reports as unsupported.
+// It is encoded back exactly as it was received.
+type UnknownBlock struct {
+	ID          string
+	Type        object.BlockType
+	HasChildren bool
+	Raw         json.RawMessage
+}
+
+// GetType retrieves the block type.
+func (b *UnknownBlock) GetType() object.BlockType {
+	return b.Type
+}
+
+// MarshalJSON returns the block as it was received.
+func (b *UnknownBlock) MarshalJSON() ([]byte, error) {
+	return b.Raw, nil
+}
+
+func newUnknownProperty(obj map[string]interface{}) (*UnknownProperty, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &UnknownProperty{Raw: raw}
+	p.Type = object.PropertyType(fmt.Sprint(obj["type"]))
+	p.ID, _ = obj["id"].(string)
+	return p, nil
+}
+
+func newUnknownBlock(data map[string]interface{}, blockType object.BlockType) (*UnknownBlock, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &UnknownBlock{Type: blockType, Raw: raw}
+	b.ID, _ = data["id"].(string)
+	b.HasChildren, _ = data["has_children"].(bool)
+	return b, nil
+}
+
+// Warning reports a part of a response which was kept as UnknownProperty or UnknownBlock.
+type Warning struct {
+	// ObjectID is the ID of the page, database or block the warning is about.
+	ObjectID string
+	// Property is the name of the unknown property, or its ID when the property
+	// was retrieved on its own. It is empty for blocks.
+	Property string
+	// Type is the unknown property or block type.
+	Type string
+}
+
+// String returns the description of the warning.
+func (w Warning) String() string {
+	if w.Property == "" {
+		return fmt.Sprintf("block %s has unknown type %s", w.ObjectID, w.Type)
+	}
+
+	return fmt.Sprintf("property %q of %s has unknown type %s", w.Property, w.ObjectID, w.Type)
+}
+
+// WithWarningHandler sets the function called for each unknown property or block in responses.
+func WithWarningHandler(handler func(Warning)) ClientOption {
+	return func(c *Client) {
+		c.warningHandler = handler
+	}
+}
+
+// warn reports the unknown properties and blocks of the decoded objects.
+func (c *Client) warn(objectID string, v interface{}) {
+	if c.warningHandler == nil {
+		return
+	}
+
+	switch v := v.(type) {
+	case *Page:
+		for name, p := range v.Properties {
+			if u, ok := p.(*UnknownProperty); ok {
+				c.warningHandler(Warning{ObjectID: v.ID, Property: name, Type: string(u.Type)})
+			}
+		}
+	case *Database:
+		for name, p := range v.Properties {
+			if u, ok := p.(*UnknownProperty); ok {
+				c.warningHandler(Warning{ObjectID: v.ID, Property: name, Type: string(u.Type)})
+			}
+		}
+	case *UnknownProperty:
+		c.warningHandler(Warning{ObjectID: objectID, Property: v.ID, Type: string(v.Type)})
+	case *UnknownBlock:
+		c.warningHandler(Warning{ObjectID: v.ID, Type: string(v.Type)})
+	case []object.Object:
+		for _, o := range v {
+			c.warn(objectID, o)
+		}
+	case []Block:
+		for _, b := range v {
+			c.warn(objectID, b)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const unknownPropertyJSON = `{"id": "pl%7B", "type": "place", "place": {"lat": 35.6812, "lon": 139.7671, "name": "Tokyo Station"}}`
+
+func TestPagesService_Get_unknownProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	warnings := []Warning{}
+	WithWarningHandler(func(w Warning) { warnings = append(warnings, w) })(client)
+
+	pageID := PageID("251d2b5f-268c-4de2-afe9-c71ff92ca95c")
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", pagesPath, pageID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, queryRowJSON(pageID.String(), "Tuscan Kale", `, "Place": `+unknownPropertyJSON))
+	})
+
+	got, err := client.Pages.Get(context.Background(), pageID)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []Warning{{ObjectID: pageID.String(), Property: "Place", Type: "place"}}
+	if diff := cmp.Diff(warnings, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if name, err := got.Title(); err != nil || name != "Tuscan Kale" {
+		t.Fatalf("unexpected title %q: %v", name, err)
+	}
+
+	place, ok := got.Properties["Place"].(*UnknownProperty)
+	if !ok {
+		t.Fatalf("expected UnknownProperty, got %T", got.Properties["Place"])
+	}
+
+	b, err := json.Marshal(&UpdatePageRequest{Properties: map[string]PropertyValue{"Place": place}})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var gotBody, wantBody interface{}
+	if err := json.Unmarshal(b, &gotBody); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"properties": {"Place": `+unknownPropertyJSON+`}}`), &wantBody); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if diff := cmp.Diff(gotBody, wantBody); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDatabasesService_Get_unknownProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	warnings := []Warning{}
+	WithWarningHandler(func(w Warning) { warnings = append(warnings, w) })(client)
+
+	databaseID := DatabaseID("668d797c-76fa-4934-9b05-ad288df2d136")
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, databaseID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"object": "database",
+			"id": "%s",
+			"properties": {
+				"Name": {"id": "title", "type": "title", "title": {}},
+				"Place": {"id": "pl%%7B", "type": "place", "place": {}}
+			}
+		}`, databaseID)
+	})
+
+	got, err := client.Databases.Get(context.Background(), databaseID)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if _, ok := got.Properties["Place"].(*UnknownProperty); !ok {
+		t.Fatalf("expected UnknownProperty, got %T", got.Properties["Place"])
+	}
+
+	want := []Warning{{ObjectID: databaseID.String(), Property: "Place", Type: "place"}}
+	if diff := cmp.Diff(warnings, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestBlocksService_ListChildren_unknownBlock(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	warnings := []Warning{}
+	WithWarningHandler(func(w Warning) { warnings = append(warnings, w) })(client)
+
+	parentID := BlockID("b55c9c91-384d-452b-81db-d1ef79372b75")
+	callout := `{"object": "block", "id": "9bc30ad4-9373-46a5-84ab-0a7845ee52e6", "type": "callout", "has_children": true, "callout": {"text": [], "icon": {"type": "emoji", "emoji": "💡"}}}`
+	mux.HandleFunc(fmt.Sprintf("/%s/%s/children", blocksPath, parentID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"object": "list", "has_more": false, "results": [
+			{"object": "block", "id": "a1d8ea8b-bb2c-4e3e-9a37-8f8b4f4e0d43", "type": "paragraph", "has_children": false, "paragraph": {"text": []}},
+			%s,
+			{"object": "block", "id": "c02fc1d3-db8b-45c5-a222-27595b15aea7", "type": "unsupported", "has_children": false, "unsupported": {}}
+		]}`, callout)
+	})
+
+	got, err := client.Blocks.ListChildren(context.Background(), parentID)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if len(got.Results) != 3 {
+		t.Fatalf("expected 3 blocks, got %d", len(got.Results))
+	}
+
+	block, ok := got.Results[1].(*UnknownBlock)
+	if !ok {
+		t.Fatalf("expected UnknownBlock, got %T", got.Results[1])
+	}
+
+	if id, hasChildren := blockID(block); id != "9bc30ad4-9373-46a5-84ab-0a7845ee52e6" || !hasChildren {
+		t.Fatalf("unexpected block %s (has children: %v)", id, hasChildren)
+	}
+
+	b, err := json.Marshal(block)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var gotBlock, wantBlock interface{}
+	if err := json.Unmarshal(b, &gotBlock); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(callout), &wantBlock); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if diff := cmp.Diff(gotBlock, wantBlock); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	want := []Warning{
+		{ObjectID: "9bc30ad4-9373-46a5-84ab-0a7845ee52e6", Type: "callout"},
+		{ObjectID: "c02fc1d3-db8b-45c5-a222-27595b15aea7", Type: string(object.UnsupportedBlockType)},
+	}
+	if diff := cmp.Diff(warnings, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,153 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// DuplicateKeyError is returned by PagesService.Upsert when several pages have the key.
+type DuplicateKeyError struct {
+	Property string
+	Value    interface{}
+	PageIDs  []string
+}
+
+// Error implements the error interface
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("%d pages have %s = %v: %s", len(e.PageIDs), e.Property, e.Value, strings.Join(e.PageIDs, ", "))
+}
+
+// Upsert updates the page of the database whose keyProperty equals keyValue with props,
+// or creates it when there is no such page. The key property is added to props on creation
+// when it is missing. Only the properties which changed are sent for existing pages.
+//
+// It returns DuplicateKeyError when more than one page has the key.
+// The key can not be empty, nor a property holding several values,
+// such as people, relations, multi-selects and files, which Notion can not match exactly.
+func (s *PagesService) Upsert(ctx context.Context, databaseID DatabaseID, keyProperty string, keyValue interface{}, props map[string]PropertyValue) (*Page, error) {
+	databaseID, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return nil, err
+	}
+
+	if emptyKey(keyValue) {
+		return nil, fmt.Errorf("key property %s has no value", keyProperty)
+	}
+
+	keyType, err := s.keyType(ctx, databaseID, keyProperty, props)
+	if err != nil {
+		return nil, err
+	}
+	if multiValueTypes[keyType] {
+		return nil, fmt.Errorf("key property %s is a %s property, which can not be matched exactly", keyProperty, keyType)
+	}
+
+	results, err := s.client.Databases.Query(ctx, databaseID, &DatabaseQuery{
+		Filter: map[CompoundFilterType]FilterObject{
+			AndFilter: []FilterObject{equalsFilter(keyProperty, keyType, keyValue)},
+		},
+		PageSize: 2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pages := []*Page{}
+	for _, r := range results.Results {
+		if p, ok := r.(*Page); ok {
+			pages = append(pages, p)
+		}
+	}
+
+	switch {
+	case len(pages) > 1 || results.HasMore:
+		ids := []string{}
+		for _, p := range pages {
+			ids = append(ids, p.ID)
+		}
+		return nil, &DuplicateKeyError{Property: keyProperty, Value: keyValue, PageIDs: ids}
+	case len(pages) == 1:
+		ureq := diffProperties(pages[0].Properties, props)
+		if ureq == nil {
+			return pages[0], nil
+		}
+		return s.UpdateProperties(ctx, PageID(pages[0].ID), ureq)
+	}
+
+	properties := map[string]PropertyValue{}
+	for name, p := range props {
+		properties[name] = p
+	}
+	if _, ok := properties[keyProperty]; !ok {
+		key, err := marshalProperty(keyType, reflect.ValueOf(keyValue))
+		if err != nil {
+			return nil, err
+		}
+		properties[keyProperty] = key
+	}
+
+	return s.Create(ctx, &CreatePageRequest{
+		Parent:     &DatabaseParent{DatabaseID: string(databaseID)},
+		Properties: properties,
+	})
+}
+
+// keyType returns the type of the key property, looking up the database schema
+// when the property is not in props.
+func (s *PagesService) keyType(ctx context.Context, databaseID DatabaseID, keyProperty string, props map[string]PropertyValue) (object.PropertyType, error) {
+	if p, ok := props[keyProperty]; ok {
+		return p.GetType(), nil
+	}
+
+	db, err := s.client.Databases.Get(ctx, databaseID)
+	if err != nil {
+		return "", err
+	}
+
+	p, ok := db.Properties[keyProperty]
+	if !ok {
+		return "", &PropertyNotFoundError{Name: keyProperty}
+	}
+
+	return p.GetType(), nil
+}
+
+// multiValueTypes are the property types holding several values,
+// which Notion can only filter by the values they contain.
+var multiValueTypes = map[object.PropertyType]bool{
+	object.PeoplePropertyType:      true,
+	object.RelationPropertyType:    true,
+	object.MultiSelectPropertyType: true,
+	object.FilesPropertyType:       true,
+}
+
+// emptyKey reports whether the key value can not identify a page.
+// Zero numbers and false are keys like any other.
+func emptyKey(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return false
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	}
+
+	return rv.IsZero()
+}
+
+// equalsFilter returns the filter matching the pages whose property equals value.
+func equalsFilter(property string, propertyType object.PropertyType, value interface{}) FilterObject {
+	return map[string]interface{}{
+		"property":           property,
+		string(propertyType): map[string]interface{}{"equals": value},
+	}
+}
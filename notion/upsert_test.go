@@ -0,0 +1,164 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestPagesService_Upsert(t *testing.T) {
+	databaseID := "668d797c-76fa-4934-9b05-ad288df2d136"
+	existing := map[string][]string{
+		"TASK-1": {"251d2b5f-268c-4de2-afe9-c71ff92ca95c"},
+		"TASK-2": {"60bdc8bd-3880-44b8-a9cd-8a145b3ffbd7", "b55c9c91-384d-452b-81db-d1ef79372b75"},
+	}
+
+	tcs := map[string]struct {
+		key         string
+		props       map[string]PropertyValue
+		wantCreated bool
+		wantUpdate  map[string]interface{}
+		wantErr     error
+	}{
+		"create": {
+			key: "TASK-3",
+			props: map[string]PropertyValue{
+				"Points": NewNumber(3),
+			},
+			wantCreated: true,
+		},
+		"update changed properties": {
+			key: "TASK-1",
+			props: map[string]PropertyValue{
+				"Points": NewNumber(5),
+				"Done":   &CheckboxProperty{Type: object.CheckboxPropertyType, Checkbox: true},
+			},
+			wantUpdate: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"Points": map[string]interface{}{"type": "number", "number": float64(5)},
+				},
+			},
+		},
+		"duplicate": {
+			key:     "TASK-2",
+			props:   map[string]PropertyValue{},
+			wantErr: &DuplicateKeyError{},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			w := newFakeWorkspace(t, mux)
+			for _, ids := range existing {
+				for _, id := range ids {
+					w.addPage(id, map[string]interface{}{"type": "database_id", "database_id": databaseID}, `{
+						"Key": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "key"}, "plain_text": "key"}]},
+						"Points": {"id": "Pts", "type": "number", "number": 3},
+						"Done": {"id": "Dn", "type": "checkbox", "checkbox": true}
+					}`)
+				}
+			}
+
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, databaseID), func(rw http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(rw, `{"object": "database", "id": "%s", "properties": {"Key": {"id": "title", "type": "title", "title": {}}}}`, databaseID)
+			})
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/query", databasesPath, databaseID), func(rw http.ResponseWriter, r *http.Request) {
+				q := struct {
+					Filter map[string][]struct {
+						Property string `json:"property"`
+						Title    struct {
+							Equals string `json:"equals"`
+						} `json:"title"`
+					} `json:"filter"`
+				}{}
+				if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+					t.Fatalf("invalid query: %v", err)
+				}
+
+				results := []interface{}{}
+				for _, id := range existing[q.Filter["and"][0].Title.Equals] {
+					results = append(results, w.pages[id])
+				}
+				_ = json.NewEncoder(rw).Encode(map[string]interface{}{"object": "list", "results": results})
+			})
+
+			got, err := client.Pages.Upsert(context.Background(), DatabaseID(databaseID), "Key", tc.key, tc.props)
+			if tc.wantErr != nil {
+				var dup *DuplicateKeyError
+				if !errors.As(err, &dup) || len(dup.PageIDs) != 2 {
+					t.Fatalf("expected DuplicateKeyError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if tc.wantCreated {
+				if len(w.created) != 1 {
+					t.Fatalf("page not created")
+				}
+				props := w.created[0]["properties"].(map[string]interface{})
+				if _, ok := props["Key"]; !ok {
+					t.Fatalf("key not set on the created page: %v", props)
+				}
+				return
+			}
+
+			if diff := cmp.Diff(w.updated[got.ID], tc.wantUpdate); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestPagesService_Upsert_invalidKey(t *testing.T) {
+	databaseID := "668d797c-76fa-4934-9b05-ad288df2d136"
+
+	tcs := map[string]struct {
+		property string
+		value    interface{}
+	}{
+		"nil date":       {property: "Due", value: nil},
+		"zero date":      {property: "Due", value: time.Time{}},
+		"empty title":    {property: "Key", value: ""},
+		"people":         {property: "Owner", value: "d40e767c-d7af-4b18-a86d-55c61f1e39a4"},
+		"relation":       {property: "Project", value: "251d2b5f-268c-4de2-afe9-c71ff92ca95c"},
+		"multi select":   {property: "Tags", value: "urgent"},
+		"nil people key": {property: "Owner", value: []string(nil)},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, databaseID), func(rw http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(rw, `{"object": "database", "id": "%s", "properties": {
+					"Key": {"id": "title", "type": "title", "title": {}},
+					"Due": {"id": "due", "type": "date", "date": {}},
+					"Owner": {"id": "own", "type": "people", "people": {}},
+					"Project": {"id": "prj", "type": "relation", "relation": {"database_id": "%s"}},
+					"Tags": {"id": "tag", "type": "multi_select", "multi_select": {"options": []}}
+				}}`, databaseID, databaseID)
+			})
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/query", databasesPath, databaseID), func(rw http.ResponseWriter, r *http.Request) {
+				t.Fatalf("query sent for an invalid key")
+			})
+
+			if _, err := client.Pages.Upsert(context.Background(), DatabaseID(databaseID), tc.property, tc.value, map[string]PropertyValue{}); err == nil {
+				t.Fatalf("expected an error for the %s key", tc.property)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ type UsersService service
 // API doc: https://developers.notion.com/reference/user
 //go:generate gomodifytags --file $GOFILE --struct User -add-tags json,mapstructure -w -transform snakecase
 type User struct {
+	Object    object.Type `json:"object,omitempty" mapstructure:"object"`
 	ID        string      `json:"id" mapstructure:"id"`
 	Type      object.Type `json:"type,omitempty" mapstructure:"type"`
 	Name      string      `json:"name,omitempty" mapstructure:"name"`
@@ -47,8 +48,13 @@ type Bot struct{}
 // Get gets user by user ID.
 //
 // API doc: https://developers.notion.com/reference/get-user
-func (s *UsersService) Get(ctx context.Context, userID string) (*User, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", usersPath, userID))
+func (s *UsersService) Get(ctx context.Context, userID UserID) (*User, error) {
+	id, err := ParseUserID(string(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", usersPath, id))
 	if err != nil {
 		return nil, err
 	}
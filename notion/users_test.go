@@ -2,6 +2,7 @@ package notion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -55,14 +56,15 @@ func TestUsersService_Get(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id   string
+		id   UserID
 		want *User
 	}{
 		"ok": {
 			"d40e767c-d7af-4b18-a86d-55c61f1e39a4",
 			&User{
-				ID:   "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
-				Type: object.Person,
+				Object: object.User,
+				ID:     "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
+				Type:   object.Person,
 				Person: &People{
 					Email: "avo@example.org",
 				},
@@ -79,7 +81,7 @@ func TestUsersService_Get(t *testing.T) {
 					t.Fatalf("no notion version header to request")
 				}
 
-				fmt.Fprint(w, getUserJSON(tc.id))
+				fmt.Fprint(w, getUserJSON(tc.id.String()))
 			})
 
 			got, err := client.Users.Get(context.Background(), tc.id)
@@ -105,8 +107,9 @@ func TestUsersService_List(t *testing.T) {
 			&ListUserResponse{
 				Results: []User{
 					{
-						ID:   "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
-						Type: object.Person,
+						Object: object.User,
+						ID:     "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
+						Type:   object.Person,
 						Person: &People{
 							Email: "avo@example.org",
 						},
@@ -114,6 +117,7 @@ func TestUsersService_List(t *testing.T) {
 						AvatarURL: "https://secure.notion-static.com/e6a352a8-8381-44d0-a1dc-9ed80e62b53d.jpg",
 					},
 					{
+						Object:    object.User,
 						ID:        "9a3b5ae0-c6e6-482d-b0e1-ed315ee6dc57",
 						Type:      object.Bot,
 						Bot:       &Bot{},
@@ -148,3 +152,16 @@ func TestUsersService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersService_Get_InvalidID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request to %s", r.URL.Path)
+	})
+
+	if _, err := client.Users.Get(context.Background(), "not-an-id"); !errors.Is(err, ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID, got %v", err)
+	}
+}
@@ -0,0 +1,349 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const (
+	// maxRichTextObjects is the maximum number of rich text objects in a property value.
+	maxRichTextObjects = 100
+	// maxRichTextLength is the maximum number of characters of a rich text content or link.
+	maxRichTextLength = 2000
+)
+
+// Violation describes why a property value does not match its database property.
+type Violation struct {
+	Property string
+	Message  string
+}
+
+// String returns the description of the violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("%q: %s", v.Property, v.Message)
+}
+
+// ValidationError is returned when page properties do not match the schema of their database.
+// It holds every violation found, not only the first one.
+type ValidationError struct {
+	DatabaseID string
+	Violations []Violation
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, v.String())
+	}
+
+	return fmt.Sprintf("properties do not match database %s: %s", e.DatabaseID, strings.Join(messages, "; "))
+}
+
+// WithSchemaValidation validates the properties of PagesService.Create and UpdateProperties
+// against the schema of the parent database before sending the request.
+// Database schemas and page parents are fetched once and cached by the client.
+func WithSchemaValidation() ClientOption {
+	return func(c *Client) {
+		c.schemas = &schemaCache{
+			databases: map[DatabaseID]map[string]PropertySchema{},
+			parents:   map[PageID]DatabaseID{},
+		}
+	}
+}
+
+// schemaCache holds the database schemas and page parents used for validation.
+type schemaCache struct {
+	mu        sync.Mutex
+	databases map[DatabaseID]map[string]PropertySchema
+	parents   map[PageID]DatabaseID
+}
+
+// ValidateProperties checks the properties against the schema of the database and
+// returns a *ValidationError listing all violations. Read-only properties are ignored,
+// since they are never sent.
+func (s *DatabasesService) ValidateProperties(ctx context.Context, databaseID DatabaseID, properties map[string]PropertyValue) error {
+	id, err := ParseDatabaseID(string(databaseID))
+	if err != nil {
+		return err
+	}
+
+	schema, err := s.client.schema(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	violations := []Violation{}
+	for name, p := range withoutReadOnly(properties) {
+		vs, err := s.client.validateProperty(ctx, schemaProperty(schema, name), p)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vs {
+			violations = append(violations, Violation{Property: name, Message: v})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{DatabaseID: id.String(), Violations: sortViolations(violations)}
+}
+
+// validateCreate validates the request when schema validation is enabled and the page is created in a database.
+func (c *Client) validateCreate(ctx context.Context, req *CreatePageRequest) error {
+	if c.schemas == nil {
+		return nil
+	}
+
+	parent, ok := req.Parent.(*DatabaseParent)
+	if !ok {
+		return nil
+	}
+
+	return c.Databases.ValidateProperties(ctx, DatabaseID(parent.DatabaseID), req.Properties)
+}
+
+// validateUpdate validates the request when schema validation is enabled and the page is in a database.
+func (c *Client) validateUpdate(ctx context.Context, pageID PageID, req *UpdatePageRequest) error {
+	if c.schemas == nil || len(req.Properties) == 0 {
+		return nil
+	}
+
+	databaseID, err := c.parentDatabase(ctx, pageID)
+	if err != nil {
+		return err
+	}
+	if databaseID == "" {
+		return nil
+	}
+
+	return c.Databases.ValidateProperties(ctx, databaseID, req.Properties)
+}
+
+// schema returns the properties of the database, from the cache when schema validation is enabled.
+func (c *Client) schema(ctx context.Context, databaseID DatabaseID) (map[string]PropertySchema, error) {
+	if c.schemas != nil {
+		c.schemas.mu.Lock()
+		schema, ok := c.schemas.databases[databaseID]
+		c.schemas.mu.Unlock()
+		if ok {
+			return schema, nil
+		}
+	}
+
+	db, err := c.Databases.Get(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.schemas != nil {
+		c.schemas.mu.Lock()
+		c.schemas.databases[databaseID] = db.Properties
+		c.schemas.mu.Unlock()
+	}
+
+	return db.Properties, nil
+}
+
+// forgetSchema drops the cached properties of the database, after its schema changed.
+func (c *Client) forgetSchema(databaseID DatabaseID) {
+	if c.schemas == nil {
+		return
+	}
+
+	c.schemas.mu.Lock()
+	delete(c.schemas.databases, databaseID)
+	c.schemas.mu.Unlock()
+}
+
+// parentDatabase returns the ID of the database the page is in, or an empty ID when it is not in a database.
+func (c *Client) parentDatabase(ctx context.Context, pageID PageID) (DatabaseID, error) {
+	id, err := ParsePageID(string(pageID))
+	if err != nil {
+		return "", err
+	}
+
+	if c.schemas != nil {
+		c.schemas.mu.Lock()
+		databaseID, ok := c.schemas.parents[id]
+		c.schemas.mu.Unlock()
+		if ok {
+			return databaseID, nil
+		}
+	}
+
+	page, err := c.Pages.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	var databaseID DatabaseID
+	if parent, ok := page.Parent.(*DatabaseParent); ok {
+		if databaseID, err = ParseDatabaseID(parent.DatabaseID); err != nil {
+			return "", err
+		}
+	}
+
+	if c.schemas != nil {
+		c.schemas.mu.Lock()
+		c.schemas.parents[id] = databaseID
+		c.schemas.mu.Unlock()
+	}
+
+	return databaseID, nil
+}
+
+// schemaProperty returns the database property with the name or ID, which are both accepted as keys by Notion.
+func schemaProperty(schema map[string]PropertySchema, key string) PropertySchema {
+	if p, ok := schema[key]; ok {
+		return p
+	}
+
+	for _, p := range schema {
+		if p.GetID() == key {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// validateProperty returns the reasons why the value can not be written to the database property.
+func (c *Client) validateProperty(ctx context.Context, schema PropertySchema, p PropertyValue) ([]string, error) {
+	if schema == nil {
+		return []string{"property does not exist in the database"}, nil
+	}
+
+	if schema.GetType() != p.GetType() {
+		return []string{fmt.Sprintf("expected a %s value, got %s", schema.GetType(), p.GetType())}, nil
+	}
+
+	switch p := p.(type) {
+	case *PageTitleProperty:
+		return validateRichText(p.Title), nil
+	case *TextProperty:
+		return validateRichText(p.Text), nil
+	case *NumberProperty:
+		// The format only changes how Notion displays the number, which is stored as written.
+		if p.Number != nil && (math.IsNaN(*p.Number) || math.IsInf(*p.Number, 0)) {
+			return []string{fmt.Sprintf("number %v can not be written", *p.Number)}, nil
+		}
+	case *SelectProperty:
+		if p.Select != nil && !hasOption(schema.(*SelectPropertySchema).Select.Options, p.Select.ID, p.Select.Name) {
+			return []string{fmt.Sprintf("option %q does not exist", p.Select.Name)}, nil
+		}
+	case *StatusProperty:
+		options := []SelectOption{}
+		for _, o := range schema.(*StatusPropertySchema).Status.Options {
+			options = append(options, SelectOption(o))
+		}
+
+		if p.Status != nil && !hasOption(options, p.Status.ID, p.Status.Name) {
+			return []string{fmt.Sprintf("status %q does not exist", p.Status.Name)}, nil
+		}
+	case *MultiSelectProperty:
+		options := []SelectOption{}
+		for _, o := range schema.(*MultiSelectPropertySchema).MultiSelect.Options {
+			options = append(options, SelectOption(o))
+		}
+
+		violations := []string{}
+		for _, o := range p.MultiSelect {
+			if !hasOption(options, o.ID, o.Name) {
+				violations = append(violations, fmt.Sprintf("option %q does not exist", o.Name))
+			}
+		}
+		return violations, nil
+	case *RelationProperty:
+		return c.validateRelation(ctx, schema.(*RelationPropertySchema).Relation.DatabaseID, p.Relation)
+	}
+
+	return nil, nil
+}
+
+// validateRelation checks that the related pages exist in the related database.
+func (c *Client) validateRelation(ctx context.Context, databaseID string, relations []Relation) ([]string, error) {
+	want, err := ParseDatabaseID(databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := []string{}
+	for _, r := range relations {
+		id, err := ParsePageID(r.ID)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("invalid related page ID %q", r.ID))
+			continue
+		}
+
+		got, err := c.parentDatabase(ctx, id)
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.Code == object.ErrObjectNotFound {
+			violations = append(violations, fmt.Sprintf("related page %s does not exist", id))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if got != want {
+			violations = append(violations, fmt.Sprintf("related page %s is not in database %s", id, want))
+		}
+	}
+
+	return violations, nil
+}
+
+// validateRichText checks the limits Notion puts on rich text values.
+func validateRichText(texts []TextObject) []string {
+	violations := []string{}
+	if len(texts) > maxRichTextObjects {
+		violations = append(violations, fmt.Sprintf("%d rich text objects, at most %d are allowed", len(texts), maxRichTextObjects))
+	}
+
+	for i, t := range texts {
+		if t.Text != nil {
+			if n := utf8.RuneCountInString(t.Text.Content); n > maxRichTextLength {
+				violations = append(violations, fmt.Sprintf("rich text %d has %d characters, at most %d are allowed", i, n, maxRichTextLength))
+			}
+		}
+		if t.Link != nil {
+			if n := utf8.RuneCountInString(t.Link.URL); n > maxRichTextLength {
+				violations = append(violations, fmt.Sprintf("link of rich text %d has %d characters, at most %d are allowed", i, n, maxRichTextLength))
+			}
+		}
+	}
+
+	return violations
+}
+
+// hasOption reports whether the option with the ID, or with the name when the ID is empty, exists.
+func hasOption(options []SelectOption, id, name string) bool {
+	for _, o := range options {
+		if (id != "" && id == o.ID) || (id == "" && name == o.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortViolations orders the violations by property so errors are stable.
+func sortViolations(violations []Violation) []Violation {
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Property < violations[j].Property
+	})
+
+	return violations
+}
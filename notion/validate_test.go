@@ -0,0 +1,222 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	groceriesDatabaseID = "668d797c-76fa-4934-9b05-ad288df2d136"
+	mealsDatabaseID     = "9b3d5d3e-0b5b-4c7b-9d8e-2f0a4a3f6c21"
+)
+
+// setupValidation serves the groceries database schema and a few pages, and counts the requests per path.
+func setupValidation() (*Client, map[string]int, func()) {
+	client, mux, _, teardown := setup()
+	WithSchemaValidation()(client)
+
+	requests := map[string]int{}
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, groceriesDatabaseID), func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		fmt.Fprintf(w, `{
+			"object": "database",
+			"id": "%s",
+			"properties": {
+				"Name": {"id": "title", "name": "Name", "type": "title", "title": {}},
+				"Price": {"id": "cU^N", "name": "Price", "type": "number", "number": {"format": "dollar"}},
+				"Food group": {"id": "TJmr", "name": "Food group", "type": "select", "select": {"options": [
+					{"id": "96eb622f-4b88-4283-919d-ece2fbed3841", "name": "Vegetable", "color": "green"}
+				]}},
+				"Stores": {"id": "st", "name": "Stores", "type": "multi_select", "multi_select": {"options": [
+					{"id": "d209b920-212c-4040-9d4a-bdf349dd8b2a", "name": "Duc Loi Market", "color": "blue"}
+				]}},
+				"Meals": {"id": "mxp^", "name": "Meals", "type": "relation", "relation": {"database_id": "%s"}},
+				"Cost": {"id": "p:sC", "name": "Cost", "type": "formula", "formula": {"expression": "prop(\"Price\")"}}
+			}
+		}`, groceriesDatabaseID, strings.ReplaceAll(mealsDatabaseID, "-", ""))
+	})
+
+	pages := map[string]string{
+		"251d2b5f-268c-4de2-afe9-c71ff92ca95c": groceriesDatabaseID,
+		"a1d8ea8b-bb2c-4e3e-9a37-8f8b4f4e0d43": mealsDatabaseID,
+	}
+	mux.HandleFunc(fmt.Sprintf("/%s/", pagesPath), func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		id := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/%s/", pagesPath))
+		databaseID, ok := pages[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"object": "error", "status": 404, "code": "object_not_found", "message": "Could not find page"}`)
+			return
+		}
+
+		if r.Method == http.MethodPatch {
+			requests["PATCH "+r.URL.Path]++
+		}
+		fmt.Fprintf(w, `{"object": "page", "id": "%s", "parent": {"type": "database_id", "database_id": "%s"}, "properties": {}}`, id, databaseID)
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s", pagesPath), func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		fmt.Fprintf(w, `{"object": "page", "id": "251d2b5f-268c-4de2-afe9-c71ff92ca95c", "parent": {"type": "database_id", "database_id": "%s"}, "properties": {}}`, groceriesDatabaseID)
+	})
+
+	return client, requests, teardown
+}
+
+func TestPagesService_Create_validation(t *testing.T) {
+	tcs := map[string]struct {
+		properties map[string]PropertyValue
+		want       []Violation
+	}{
+		"valid": {
+			properties: map[string]PropertyValue{
+				"Name":       &PageTitleProperty{Title: []TextObject{{Text: &Text{Content: "Tuscan Kale"}}}},
+				"cU^N":       NewNumber(2.5),
+				"Food group": &SelectProperty{Select: &SelectOption{Name: "Vegetable"}},
+				"Stores":     &MultiSelectProperty{MultiSelect: []MultiSelectOption{{ID: "d209b920-212c-4040-9d4a-bdf349dd8b2a"}}},
+				"Meals":      &RelationProperty{Relation: []Relation{{ID: "a1d8ea8bbb2c4e3e9a378f8b4f4e0d43"}}},
+				"Cost":       &FormulaProperty{},
+			},
+		},
+		"invalid": {
+			properties: map[string]PropertyValue{
+				"Name":       &PageTitleProperty{Title: []TextObject{{Text: &Text{Content: strings.Repeat("a", 2001)}}}},
+				"Price":      &TextProperty{},
+				"Calories":   NewNumber(100),
+				"Food group": &SelectProperty{Select: &SelectOption{Name: "Fruit"}},
+				"Stores":     &MultiSelectProperty{MultiSelect: []MultiSelectOption{{Name: "Duc Loi Market"}, {Name: "Rainbow Grocery"}}},
+				"Meals": &RelationProperty{Relation: []Relation{
+					{ID: "251d2b5f-268c-4de2-afe9-c71ff92ca95c"},
+					{ID: "c02fc1d3-db8b-45c5-a222-27595b15aea7"},
+					{ID: "meal"},
+				}},
+			},
+			want: []Violation{
+				{Property: "Calories", Message: "property does not exist in the database"},
+				{Property: "Food group", Message: `option "Fruit" does not exist`},
+				{Property: "Meals", Message: "related page 251d2b5f-268c-4de2-afe9-c71ff92ca95c is not in database " + mealsDatabaseID},
+				{Property: "Meals", Message: "related page c02fc1d3-db8b-45c5-a222-27595b15aea7 does not exist"},
+				{Property: "Meals", Message: `invalid related page ID "meal"`},
+				{Property: "Name", Message: "rich text 0 has 2001 characters, at most 2000 are allowed"},
+				{Property: "Price", Message: "expected a number value, got text"},
+				{Property: "Stores", Message: `option "Rainbow Grocery" does not exist`},
+			},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, requests, teardown := setupValidation()
+			defer teardown()
+
+			_, err := client.Pages.Create(context.Background(), &CreatePageRequest{
+				Parent:     &DatabaseParent{DatabaseID: groceriesDatabaseID},
+				Properties: tc.properties,
+			})
+
+			if tc.want == nil {
+				if err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				if requests["/"+pagesPath] != 1 {
+					t.Fatalf("expected the page to be created")
+				}
+				return
+			}
+
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+
+			if diff := cmp.Diff(verr.Violations, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if requests["/"+pagesPath] != 0 {
+				t.Fatalf("invalid page was sent")
+			}
+		})
+	}
+}
+
+func TestPagesService_UpdateProperties_validation(t *testing.T) {
+	client, requests, teardown := setupValidation()
+	defer teardown()
+
+	pageID := PageID("251d2b5f-268c-4de2-afe9-c71ff92ca95c")
+	for i := 0; i < 2; i++ {
+		_, err := client.Pages.UpdateProperties(context.Background(), pageID, &UpdatePageRequest{
+			Properties: map[string]PropertyValue{"Price": NewNumber(math.NaN())},
+		})
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("expected ValidationError, got %v", err)
+		}
+
+		want := []Violation{{Property: "Price", Message: "number NaN can not be written"}}
+		if diff := cmp.Diff(verr.Violations, want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	}
+
+	if _, err := client.Pages.UpdateProperties(context.Background(), pageID, &UpdatePageRequest{
+		Properties: map[string]PropertyValue{"Price": NewNumber(3)},
+	}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := map[string]int{
+		fmt.Sprintf("/%s/%s", databasesPath, groceriesDatabaseID): 1,
+		fmt.Sprintf("/%s/%s", pagesPath, pageID):                  2,
+		fmt.Sprintf("PATCH /%s/%s", pagesPath, pageID):            1,
+	}
+	if diff := cmp.Diff(requests, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := &ValidationError{DatabaseID: groceriesDatabaseID, Violations: []Violation{
+		{Property: "Calories", Message: "property does not exist in the database"},
+		{Property: "Food group", Message: `option "Fruit" does not exist`},
+	}}
+
+	want := `properties do not match database 668d797c-76fa-4934-9b05-ad288df2d136: "Calories": property does not exist in the database; "Food group": option "Fruit" does not exist`
+	if diff := cmp.Diff(err.Error(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDatabasesService_Update_forgetsSchema(t *testing.T) {
+	client, requests, teardown := setupValidation()
+	defer teardown()
+
+	properties := map[string]PropertyValue{"Price": NewNumber(3)}
+	if err := client.Databases.ValidateProperties(context.Background(), groceriesDatabaseID, properties); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if _, err := client.Databases.Update(context.Background(), groceriesDatabaseID, &UpdateDatabaseRequest{
+		Properties: map[string]*PropertyPatch{"Price": {Name: "Unit price"}},
+	}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if err := client.Databases.ValidateProperties(context.Background(), groceriesDatabaseID, properties); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := map[string]int{fmt.Sprintf("/%s/%s", databasesPath, groceriesDatabaseID): 3}
+	if diff := cmp.Diff(requests, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}